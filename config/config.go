@@ -24,6 +24,7 @@ type Multicall struct {
 type ContractAddresses struct {
 	Core        string
 	PerpsMarket string
+	SpotMarket  string
 	ERC7412     string
 	Forwarder   string
 }
@@ -32,6 +33,7 @@ type ContractAddresses struct {
 type FirstContractBlocks struct {
 	Core        uint64
 	PerpsMarket uint64
+	SpotMarket  uint64
 }
 
 // GetOptimismGoerliDefaultConfig is used to get default lib config for goerli optimism test net
@@ -100,12 +102,15 @@ func GetBaseAndromedaDefaultConfig(rpcURL string) *PerpsvConfig {
 		ContractAddresses: &ContractAddresses{
 			Core:        "0xF4Df9Dd327Fd30695d478c3c8a2fffAddcdD0d31",
 			PerpsMarket: "0x75c43165ea38cB857C45216a37C5405A7656673c",
+			SpotMarket:  "0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4",
 			Forwarder:   "0xE2C5658cC5C448B48141168f3e475dF8f65A1e3e",
 			ERC7412:     "0xEa7a8f0fDD16Ccd46BA541Fb657a0A7FD7E36261",
 		},
 		FirstContractBlocks: &FirstContractBlocks{
 			Core:        13044276,
 			PerpsMarket: 13044488,
+			// spot market is deployed within the same system as core, so core first block is used as a safe lower bound
+			SpotMarket: 13044276,
 		},
 		ConnectionTimeout: time.Second * 30,
 		ReadTimeout:       time.Second * 15,
@@ -128,12 +133,15 @@ func GetBaseMainnetDefaultConfig(rpcURL string) *PerpsvConfig {
 		ContractAddresses: &ContractAddresses{
 			Core:        "0x32C222A9A159782aFD7529c87FA34b96CA72C696",
 			PerpsMarket: "0x0A2AF931eFFd34b81ebcc57E3d3c9B1E1dE1C9Ce",
+			SpotMarket:  "0x18141523403e2595D31b22604AcB8Fc06a4CaA61",
 			Forwarder:   "0xE2C5658cC5C448B48141168f3e475dF8f65A1e3e",
 			ERC7412:     "0xEb38e347F24ea04ffA945a475BdD949E0c383A0F",
 		},
 		FirstContractBlocks: &FirstContractBlocks{
 			Core:        7889212,
 			PerpsMarket: 7889389,
+			// spot market is deployed within the same system as core, so core first block is used as a safe lower bound
+			SpotMarket: 7889212,
 		},
 		ConnectionTimeout: time.Second * 30,
 		ReadTimeout:       time.Second * 15,
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	stdErrors "errors"
+	"fmt"
+)
 
 var (
 	// BlankRPCURLErr is used when blank rpc url is received
@@ -29,6 +32,16 @@ var (
 	ChainIDNotSupported = fmt.Errorf("chain id not supported")
 	// FetchErr is used when error occurred when fetch to external dependency
 	FetchErr = fmt.Errorf("fetch error")
+	// OracleDataRequiredErr is used when contract call reverted with ERC7412 `OracleDataRequired` error
+	OracleDataRequiredErr = fmt.Errorf("oracle data required")
+	// NotFoundErr is used when requested entity does not exist
+	NotFoundErr = fmt.Errorf("not found")
+	// PoolNotFoundErr is used when requested pool does not exist in the core
+	PoolNotFoundErr = fmt.Errorf("pool %w", NotFoundErr)
+	// AccountNotFoundErr is used when requested account does not exist in the perps market
+	AccountNotFoundErr = fmt.Errorf("account %w", NotFoundErr)
+	// BatchErr is used when some items of the batch request failed
+	BatchErr = fmt.Errorf("batch error")
 )
 
 func GetFetchErr(err error, service string) error {
@@ -43,6 +56,10 @@ func GetInitContractErr(err error) error {
 	return fmt.Errorf("%w: %w", InitContractErr, err)
 }
 
+func GetBlankContractAddrErr(contract string) error {
+	return fmt.Errorf("%v %w", contract, BlankContractAddrErr)
+}
+
 func GetFilterErr(err error, contract string) error {
 	return fmt.Errorf("%v %w: %w", contract, FilterErr, err)
 }
@@ -66,3 +83,15 @@ func GetUnsupportedErr(enum string) error {
 func GetInvalidArgumentErr(reason string) error {
 	return fmt.Errorf("%w: %v", InvalidArgumentErr, reason)
 }
+
+func GetOracleDataRequiredErr(err error, contract string, method string) error {
+	return fmt.Errorf("%v %w for %v method: %w", contract, OracleDataRequiredErr, method, err)
+}
+
+func GetNotFoundErr(entity string) error {
+	return fmt.Errorf("%v %w", entity, NotFoundErr)
+}
+
+func GetBatchErr(errs []error) error {
+	return fmt.Errorf("%w: %w", BatchErr, stdErrors.Join(errs...))
+}
@@ -36,6 +36,51 @@ func (m *MockIService) EXPECT() *MockIServiceMockRecorder {
 	return m.recorder
 }
 
+// CanLiquidate mocks base method.
+func (m *MockIService) CanLiquidate(accountId *big.Int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanLiquidate", accountId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanLiquidate indicates an expected call of CanLiquidate.
+func (mr *MockIServiceMockRecorder) CanLiquidate(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanLiquidate", reflect.TypeOf((*MockIService)(nil).CanLiquidate), accountId)
+}
+
+// CanLiquidateAccounts mocks base method.
+func (m *MockIService) CanLiquidateAccounts(accountIds []*big.Int) ([]*models.LiquidationCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanLiquidateAccounts", accountIds)
+	ret0, _ := ret[0].([]*models.LiquidationCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanLiquidateAccounts indicates an expected call of CanLiquidateAccounts.
+func (mr *MockIServiceMockRecorder) CanLiquidateAccounts(accountIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanLiquidateAccounts", reflect.TypeOf((*MockIService)(nil).CanLiquidateAccounts), accountIds)
+}
+
+// ComputeOrderFees mocks base method.
+func (m *MockIService) ComputeOrderFees(marketId, sizeDelta *big.Int) (*models.OrderFeeQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeOrderFees", marketId, sizeDelta)
+	ret0, _ := ret[0].(*models.OrderFeeQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComputeOrderFees indicates an expected call of ComputeOrderFees.
+func (mr *MockIServiceMockRecorder) ComputeOrderFees(marketId, sizeDelta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeOrderFees", reflect.TypeOf((*MockIService)(nil).ComputeOrderFees), marketId, sizeDelta)
+}
+
 // FormatAccount mocks base method.
 func (m *MockIService) FormatAccount(id *big.Int) (*models.Account, error) {
 	m.ctrl.T.Helper()
@@ -81,11 +126,56 @@ func (mr *MockIServiceMockRecorder) FormatAccountsLimit(limit interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FormatAccountsLimit", reflect.TypeOf((*MockIService)(nil).FormatAccountsLimit), limit)
 }
 
+// GetAccountAvailableCollateral mocks base method.
+func (m *MockIService) GetAccountAvailableCollateral(accountID *big.Int, collateralType common.Address) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAvailableCollateral", accountID, collateralType)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAvailableCollateral indicates an expected call of GetAccountAvailableCollateral.
+func (mr *MockIServiceMockRecorder) GetAccountAvailableCollateral(accountID, collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAvailableCollateral", reflect.TypeOf((*MockIService)(nil).GetAccountAvailableCollateral), accountID, collateralType)
+}
+
+// GetAccountCollateralIds mocks base method.
+func (m *MockIService) GetAccountCollateralIds(accountId *big.Int) ([]*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountCollateralIds", accountId)
+	ret0, _ := ret[0].([]*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountCollateralIds indicates an expected call of GetAccountCollateralIds.
+func (mr *MockIServiceMockRecorder) GetAccountCollateralIds(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountCollateralIds", reflect.TypeOf((*MockIService)(nil).GetAccountCollateralIds), accountId)
+}
+
+// GetAccountCollaterals mocks base method.
+func (m *MockIService) GetAccountCollaterals(accountId *big.Int) ([]*models.AccountCollateral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountCollaterals", accountId)
+	ret0, _ := ret[0].([]*models.AccountCollateral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountCollaterals indicates an expected call of GetAccountCollaterals.
+func (mr *MockIServiceMockRecorder) GetAccountCollaterals(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountCollaterals", reflect.TypeOf((*MockIService)(nil).GetAccountCollaterals), accountId)
+}
+
 // GetAccountLastInteraction mocks base method.
-func (m *MockIService) GetAccountLastInteraction(accountId *big.Int) (*big.Int, error) {
+func (m *MockIService) GetAccountLastInteraction(accountId *big.Int) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccountLastInteraction", accountId)
-	ret0, _ := ret[0].(*big.Int)
+	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -96,6 +186,21 @@ func (mr *MockIServiceMockRecorder) GetAccountLastInteraction(accountId interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLastInteraction", reflect.TypeOf((*MockIService)(nil).GetAccountLastInteraction), accountId)
 }
 
+// GetAccountOpenPositions mocks base method.
+func (m *MockIService) GetAccountOpenPositions(accountID *big.Int) ([]*models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountOpenPositions", accountID)
+	ret0, _ := ret[0].([]*models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountOpenPositions indicates an expected call of GetAccountOpenPositions.
+func (mr *MockIServiceMockRecorder) GetAccountOpenPositions(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountOpenPositions", reflect.TypeOf((*MockIService)(nil).GetAccountOpenPositions), accountID)
+}
+
 // GetAccountOwner mocks base method.
 func (m *MockIService) GetAccountOwner(accountId *big.Int) (string, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +216,36 @@ func (mr *MockIServiceMockRecorder) GetAccountOwner(accountId interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountOwner", reflect.TypeOf((*MockIService)(nil).GetAccountOwner), accountId)
 }
 
+// GetAccountPermissions mocks base method.
+func (m *MockIService) GetAccountPermissions(accountId *big.Int) ([]*models.AccountPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountPermissions", accountId)
+	ret0, _ := ret[0].([]*models.AccountPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountPermissions indicates an expected call of GetAccountPermissions.
+func (mr *MockIServiceMockRecorder) GetAccountPermissions(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountPermissions", reflect.TypeOf((*MockIService)(nil).GetAccountPermissions), accountId)
+}
+
+// GetApprovedPools mocks base method.
+func (m *MockIService) GetApprovedPools() ([]*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovedPools")
+	ret0, _ := ret[0].([]*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovedPools indicates an expected call of GetApprovedPools.
+func (mr *MockIServiceMockRecorder) GetApprovedPools() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovedPools", reflect.TypeOf((*MockIService)(nil).GetApprovedPools))
+}
+
 // GetAvailableMargin mocks base method.
 func (m *MockIService) GetAvailableMargin(accountId *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +276,36 @@ func (mr *MockIServiceMockRecorder) GetCollateralAmount(accountId, marketId inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateralAmount", reflect.TypeOf((*MockIService)(nil).GetCollateralAmount), accountId, marketId)
 }
 
+// GetCollateralConfiguration mocks base method.
+func (m *MockIService) GetCollateralConfiguration(collateralType common.Address) (*models.CollateralConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollateralConfiguration", collateralType)
+	ret0, _ := ret[0].(*models.CollateralConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollateralConfiguration indicates an expected call of GetCollateralConfiguration.
+func (mr *MockIServiceMockRecorder) GetCollateralConfiguration(collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateralConfiguration", reflect.TypeOf((*MockIService)(nil).GetCollateralConfiguration), collateralType)
+}
+
+// GetCollateralConfigurations mocks base method.
+func (m *MockIService) GetCollateralConfigurations(hideDisabled bool) ([]*models.CollateralConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollateralConfigurations", hideDisabled)
+	ret0, _ := ret[0].([]*models.CollateralConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollateralConfigurations indicates an expected call of GetCollateralConfigurations.
+func (mr *MockIServiceMockRecorder) GetCollateralConfigurations(hideDisabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateralConfigurations", reflect.TypeOf((*MockIService)(nil).GetCollateralConfigurations), hideDisabled)
+}
+
 // GetCollateralPrice mocks base method.
 func (m *MockIService) GetCollateralPrice(blockNumber *big.Int, collateralType common.Address) (*models.CollateralPrice, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +321,36 @@ func (mr *MockIServiceMockRecorder) GetCollateralPrice(blockNumber, collateralTy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollateralPrice", reflect.TypeOf((*MockIService)(nil).GetCollateralPrice), blockNumber, collateralType)
 }
 
+// GetCoreAccountTokenAddress mocks base method.
+func (m *MockIService) GetCoreAccountTokenAddress() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoreAccountTokenAddress")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoreAccountTokenAddress indicates an expected call of GetCoreAccountTokenAddress.
+func (mr *MockIServiceMockRecorder) GetCoreAccountTokenAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreAccountTokenAddress", reflect.TypeOf((*MockIService)(nil).GetCoreAccountTokenAddress))
+}
+
+// GetFillPrice mocks base method.
+func (m *MockIService) GetFillPrice(marketID, sizeDelta *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFillPrice", marketID, sizeDelta)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFillPrice indicates an expected call of GetFillPrice.
+func (mr *MockIServiceMockRecorder) GetFillPrice(marketID, sizeDelta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFillPrice", reflect.TypeOf((*MockIService)(nil).GetFillPrice), marketID, sizeDelta)
+}
+
 // GetFoundingRate mocks base method.
 func (m *MockIService) GetFoundingRate(marketId *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +381,66 @@ func (mr *MockIServiceMockRecorder) GetFundingParameters(marketId interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingParameters", reflect.TypeOf((*MockIService)(nil).GetFundingParameters), marketId)
 }
 
+// GetFundingRate mocks base method.
+func (m *MockIService) GetFundingRate(marketID *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingRate", marketID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingRate indicates an expected call of GetFundingRate.
+func (mr *MockIServiceMockRecorder) GetFundingRate(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingRate", reflect.TypeOf((*MockIService)(nil).GetFundingRate), marketID)
+}
+
+// GetFundingVelocity mocks base method.
+func (m *MockIService) GetFundingVelocity(marketID *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingVelocity", marketID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingVelocity indicates an expected call of GetFundingVelocity.
+func (mr *MockIServiceMockRecorder) GetFundingVelocity(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingVelocity", reflect.TypeOf((*MockIService)(nil).GetFundingVelocity), marketID)
+}
+
+// GetIndexPrice mocks base method.
+func (m *MockIService) GetIndexPrice(marketID *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndexPrice", marketID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndexPrice indicates an expected call of GetIndexPrice.
+func (mr *MockIServiceMockRecorder) GetIndexPrice(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndexPrice", reflect.TypeOf((*MockIService)(nil).GetIndexPrice), marketID)
+}
+
+// GetKeeperRewardGuards mocks base method.
+func (m *MockIService) GetKeeperRewardGuards() (*models.KeeperRewardGuards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeeperRewardGuards")
+	ret0, _ := ret[0].(*models.KeeperRewardGuards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeeperRewardGuards indicates an expected call of GetKeeperRewardGuards.
+func (mr *MockIServiceMockRecorder) GetKeeperRewardGuards() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeeperRewardGuards", reflect.TypeOf((*MockIService)(nil).GetKeeperRewardGuards))
+}
+
 // GetLiquidationParameters mocks base method.
 func (m *MockIService) GetLiquidationParameters(marketId *big.Int) (*models.LiquidationParameters, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +456,51 @@ func (mr *MockIServiceMockRecorder) GetLiquidationParameters(marketId interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiquidationParameters", reflect.TypeOf((*MockIService)(nil).GetLiquidationParameters), marketId)
 }
 
+// GetMarketBackingSummary mocks base method.
+func (m *MockIService) GetMarketBackingSummary(marketID *big.Int) (*models.MarketBackingSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarketBackingSummary", marketID)
+	ret0, _ := ret[0].(*models.MarketBackingSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarketBackingSummary indicates an expected call of GetMarketBackingSummary.
+func (mr *MockIServiceMockRecorder) GetMarketBackingSummary(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketBackingSummary", reflect.TypeOf((*MockIService)(nil).GetMarketBackingSummary), marketID)
+}
+
+// GetMarketCollateralValue mocks base method.
+func (m *MockIService) GetMarketCollateralValue(marketID *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarketCollateralValue", marketID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarketCollateralValue indicates an expected call of GetMarketCollateralValue.
+func (mr *MockIServiceMockRecorder) GetMarketCollateralValue(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketCollateralValue", reflect.TypeOf((*MockIService)(nil).GetMarketCollateralValue), marketID)
+}
+
+// GetMarketDebtSummary mocks base method.
+func (m *MockIService) GetMarketDebtSummary(marketID *big.Int) (*models.MarketDebtSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarketDebtSummary", marketID)
+	ret0, _ := ret[0].(*models.MarketDebtSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarketDebtSummary indicates an expected call of GetMarketDebtSummary.
+func (mr *MockIServiceMockRecorder) GetMarketDebtSummary(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketDebtSummary", reflect.TypeOf((*MockIService)(nil).GetMarketDebtSummary), marketID)
+}
+
 // GetMarketIDs mocks base method.
 func (m *MockIService) GetMarketIDs() ([]*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -231,161 +531,1271 @@ func (mr *MockIServiceMockRecorder) GetMarketMetadata(marketID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketMetadata", reflect.TypeOf((*MockIService)(nil).GetMarketMetadata), marketID)
 }
 
-// GetMarketSummary mocks base method.
-func (m *MockIService) GetMarketSummary(marketID *big.Int) (*models.MarketSummary, error) {
+// GetMarketMinDelegateTime mocks base method.
+func (m *MockIService) GetMarketMinDelegateTime(marketID *big.Int) (uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMarketSummary", marketID)
-	ret0, _ := ret[0].(*models.MarketSummary)
+	ret := m.ctrl.Call(m, "GetMarketMinDelegateTime", marketID)
+	ret0, _ := ret[0].(uint32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMarketSummary indicates an expected call of GetMarketSummary.
-func (mr *MockIServiceMockRecorder) GetMarketSummary(marketID interface{}) *gomock.Call {
+// GetMarketMinDelegateTime indicates an expected call of GetMarketMinDelegateTime.
+func (mr *MockIServiceMockRecorder) GetMarketMinDelegateTime(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketSummary", reflect.TypeOf((*MockIService)(nil).GetMarketSummary), marketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketMinDelegateTime", reflect.TypeOf((*MockIService)(nil).GetMarketMinDelegateTime), marketID)
 }
 
-// GetPosition mocks base method.
-func (m *MockIService) GetPosition(accountID, marketID *big.Int) (*models.Position, error) {
+// GetMarketMinLiquidityRatio mocks base method.
+func (m *MockIService) GetMarketMinLiquidityRatio(marketID *big.Int) (*models.MinLiquidityRatio, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPosition", accountID, marketID)
-	ret0, _ := ret[0].(*models.Position)
+	ret := m.ctrl.Call(m, "GetMarketMinLiquidityRatio", marketID)
+	ret0, _ := ret[0].(*models.MinLiquidityRatio)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPosition indicates an expected call of GetPosition.
-func (mr *MockIServiceMockRecorder) GetPosition(accountID, marketID interface{}) *gomock.Call {
+// GetMarketMinLiquidityRatio indicates an expected call of GetMarketMinLiquidityRatio.
+func (mr *MockIServiceMockRecorder) GetMarketMinLiquidityRatio(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockIService)(nil).GetPosition), accountID, marketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketMinLiquidityRatio", reflect.TypeOf((*MockIService)(nil).GetMarketMinLiquidityRatio), marketID)
 }
 
-// GetRequiredMaintenanceMargin mocks base method.
-func (m *MockIService) GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error) {
+// GetMarketNetIssuance mocks base method.
+func (m *MockIService) GetMarketNetIssuance(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRequiredMaintenanceMargin", accountId)
+	ret := m.ctrl.Call(m, "GetMarketNetIssuance", marketID)
 	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRequiredMaintenanceMargin indicates an expected call of GetRequiredMaintenanceMargin.
-func (mr *MockIServiceMockRecorder) GetRequiredMaintenanceMargin(accountId interface{}) *gomock.Call {
+// GetMarketNetIssuance indicates an expected call of GetMarketNetIssuance.
+func (mr *MockIServiceMockRecorder) GetMarketNetIssuance(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequiredMaintenanceMargin", reflect.TypeOf((*MockIService)(nil).GetRequiredMaintenanceMargin), accountId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketNetIssuance", reflect.TypeOf((*MockIService)(nil).GetMarketNetIssuance), marketID)
 }
 
-// RetrieveAccountLiquidationsLimit mocks base method.
-func (m *MockIService) RetrieveAccountLiquidationsLimit(limit uint64) ([]*models.AccountLiquidated, error) {
+// GetMarketReportedDebt mocks base method.
+func (m *MockIService) GetMarketReportedDebt(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveAccountLiquidationsLimit", limit)
-	ret0, _ := ret[0].([]*models.AccountLiquidated)
+	ret := m.ctrl.Call(m, "GetMarketReportedDebt", marketID)
+	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveAccountLiquidationsLimit indicates an expected call of RetrieveAccountLiquidationsLimit.
-func (mr *MockIServiceMockRecorder) RetrieveAccountLiquidationsLimit(limit interface{}) *gomock.Call {
+// GetMarketReportedDebt indicates an expected call of GetMarketReportedDebt.
+func (mr *MockIServiceMockRecorder) GetMarketReportedDebt(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveAccountLiquidationsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveAccountLiquidationsLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketReportedDebt", reflect.TypeOf((*MockIService)(nil).GetMarketReportedDebt), marketID)
 }
 
-// RetrieveCollateralDepositedLimit mocks base method.
-func (m *MockIService) RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error) {
+// GetMarketSize mocks base method.
+func (m *MockIService) GetMarketSize(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveCollateralDepositedLimit", limit)
-	ret0, _ := ret[0].([]*models.CollateralDeposited)
+	ret := m.ctrl.Call(m, "GetMarketSize", marketID)
+	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveCollateralDepositedLimit indicates an expected call of RetrieveCollateralDepositedLimit.
-func (mr *MockIServiceMockRecorder) RetrieveCollateralDepositedLimit(limit interface{}) *gomock.Call {
+// GetMarketSize indicates an expected call of GetMarketSize.
+func (mr *MockIServiceMockRecorder) GetMarketSize(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralDepositedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralDepositedLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketSize", reflect.TypeOf((*MockIService)(nil).GetMarketSize), marketID)
 }
 
-// RetrieveCollateralWithdrawnLimit mocks base method.
-func (m *MockIService) RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error) {
+// GetMarketSkew mocks base method.
+func (m *MockIService) GetMarketSkew(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveCollateralWithdrawnLimit", limit)
-	ret0, _ := ret[0].([]*models.CollateralWithdrawn)
+	ret := m.ctrl.Call(m, "GetMarketSkew", marketID)
+	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveCollateralWithdrawnLimit indicates an expected call of RetrieveCollateralWithdrawnLimit.
-func (mr *MockIServiceMockRecorder) RetrieveCollateralWithdrawnLimit(limit interface{}) *gomock.Call {
+// GetMarketSkew indicates an expected call of GetMarketSkew.
+func (mr *MockIServiceMockRecorder) GetMarketSkew(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralWithdrawnLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralWithdrawnLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketSkew", reflect.TypeOf((*MockIService)(nil).GetMarketSkew), marketID)
 }
 
-// RetrieveDelegationUpdatedLimit mocks base method.
-func (m *MockIService) RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error) {
+// GetMarketSummary mocks base method.
+func (m *MockIService) GetMarketSummary(marketID *big.Int) (*models.MarketSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveDelegationUpdatedLimit", limit)
-	ret0, _ := ret[0].([]*models.DelegationUpdated)
+	ret := m.ctrl.Call(m, "GetMarketSummary", marketID)
+	ret0, _ := ret[0].(*models.MarketSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveDelegationUpdatedLimit indicates an expected call of RetrieveDelegationUpdatedLimit.
-func (mr *MockIServiceMockRecorder) RetrieveDelegationUpdatedLimit(limit interface{}) *gomock.Call {
+// GetMarketSummary indicates an expected call of GetMarketSummary.
+func (mr *MockIServiceMockRecorder) GetMarketSummary(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveDelegationUpdatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveDelegationUpdatedLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketSummary", reflect.TypeOf((*MockIService)(nil).GetMarketSummary), marketID)
 }
 
-// RetrieveLiquidations mocks base method.
-func (m *MockIService) RetrieveLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.Liquidation, error) {
+// GetMarketTotalDebt mocks base method.
+func (m *MockIService) GetMarketTotalDebt(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveLiquidations", fromBlock, toBLock)
-	ret0, _ := ret[0].([]*models.Liquidation)
+	ret := m.ctrl.Call(m, "GetMarketTotalDebt", marketID)
+	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveLiquidations indicates an expected call of RetrieveLiquidations.
-func (mr *MockIServiceMockRecorder) RetrieveLiquidations(fromBlock, toBLock interface{}) *gomock.Call {
+// GetMarketTotalDebt indicates an expected call of GetMarketTotalDebt.
+func (mr *MockIServiceMockRecorder) GetMarketTotalDebt(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveLiquidations", reflect.TypeOf((*MockIService)(nil).RetrieveLiquidations), fromBlock, toBLock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketTotalDebt", reflect.TypeOf((*MockIService)(nil).GetMarketTotalDebt), marketID)
 }
 
-// RetrieveLiquidationsLimit mocks base method.
-func (m *MockIService) RetrieveLiquidationsLimit(limit uint64) ([]*models.Liquidation, error) {
+// GetMarkets mocks base method.
+func (m *MockIService) GetMarkets() ([]*models.MarketMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveLiquidationsLimit", limit)
-	ret0, _ := ret[0].([]*models.Liquidation)
+	ret := m.ctrl.Call(m, "GetMarkets")
+	ret0, _ := ret[0].([]*models.MarketMetadata)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveLiquidationsLimit indicates an expected call of RetrieveLiquidationsLimit.
-func (mr *MockIServiceMockRecorder) RetrieveLiquidationsLimit(limit interface{}) *gomock.Call {
+// GetMarkets indicates an expected call of GetMarkets.
+func (mr *MockIServiceMockRecorder) GetMarkets() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveLiquidationsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveLiquidationsLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarkets", reflect.TypeOf((*MockIService)(nil).GetMarkets))
 }
 
-// RetrieveMarketUpdates mocks base method.
-func (m *MockIService) RetrieveMarketUpdates(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdate, error) {
+// GetMaxMarketSize mocks base method.
+func (m *MockIService) GetMaxMarketSize(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveMarketUpdates", fromBlock, toBLock)
-	ret0, _ := ret[0].([]*models.MarketUpdate)
+	ret := m.ctrl.Call(m, "GetMaxMarketSize", marketID)
+	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveMarketUpdates indicates an expected call of RetrieveMarketUpdates.
-func (mr *MockIServiceMockRecorder) RetrieveMarketUpdates(fromBlock, toBLock interface{}) *gomock.Call {
+// GetMaxMarketSize indicates an expected call of GetMaxMarketSize.
+func (mr *MockIServiceMockRecorder) GetMaxMarketSize(marketID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdates", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdates), fromBlock, toBLock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxMarketSize", reflect.TypeOf((*MockIService)(nil).GetMaxMarketSize), marketID)
 }
 
-// RetrieveMarketUpdatesBig mocks base method.
-func (m *MockIService) RetrieveMarketUpdatesBig(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdateBig, error) {
+// GetMaxOpenInterest mocks base method.
+func (m *MockIService) GetMaxOpenInterest(marketID *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveMarketUpdatesBig", fromBlock, toBLock)
-	ret0, _ := ret[0].([]*models.MarketUpdateBig)
+	ret := m.ctrl.Call(m, "GetMaxOpenInterest", marketID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaxOpenInterest indicates an expected call of GetMaxOpenInterest.
+func (mr *MockIServiceMockRecorder) GetMaxOpenInterest(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxOpenInterest", reflect.TypeOf((*MockIService)(nil).GetMaxOpenInterest), marketID)
+}
+
+// GetMinLiquidityRatio mocks base method.
+func (m *MockIService) GetMinLiquidityRatio() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMinLiquidityRatio")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMinLiquidityRatio indicates an expected call of GetMinLiquidityRatio.
+func (mr *MockIServiceMockRecorder) GetMinLiquidityRatio() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMinLiquidityRatio", reflect.TypeOf((*MockIService)(nil).GetMinLiquidityRatio))
+}
+
+// GetNominatedPoolOwner mocks base method.
+func (m *MockIService) GetNominatedPoolOwner(poolID *big.Int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNominatedPoolOwner", poolID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNominatedPoolOwner indicates an expected call of GetNominatedPoolOwner.
+func (mr *MockIServiceMockRecorder) GetNominatedPoolOwner(poolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNominatedPoolOwner", reflect.TypeOf((*MockIService)(nil).GetNominatedPoolOwner), poolID)
+}
+
+// GetOracleManagerAddress mocks base method.
+func (m *MockIService) GetOracleManagerAddress() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOracleManagerAddress")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOracleManagerAddress indicates an expected call of GetOracleManagerAddress.
+func (mr *MockIServiceMockRecorder) GetOracleManagerAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOracleManagerAddress", reflect.TypeOf((*MockIService)(nil).GetOracleManagerAddress))
+}
+
+// GetOrderFees mocks base method.
+func (m *MockIService) GetOrderFees(marketId *big.Int) (*models.OrderFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderFees", marketId)
+	ret0, _ := ret[0].(*models.OrderFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderFees indicates an expected call of GetOrderFees.
+func (mr *MockIServiceMockRecorder) GetOrderFees(marketId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderFees", reflect.TypeOf((*MockIService)(nil).GetOrderFees), marketId)
+}
+
+// GetPendingOrder mocks base method.
+func (m *MockIService) GetPendingOrder(accountId *big.Int) (*models.PendingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOrder", accountId)
+	ret0, _ := ret[0].(*models.PendingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOrder indicates an expected call of GetPendingOrder.
+func (mr *MockIServiceMockRecorder) GetPendingOrder(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOrder", reflect.TypeOf((*MockIService)(nil).GetPendingOrder), accountId)
+}
+
+// GetPerpsAccountTokenAddress mocks base method.
+func (m *MockIService) GetPerpsAccountTokenAddress() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerpsAccountTokenAddress")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerpsAccountTokenAddress indicates an expected call of GetPerpsAccountTokenAddress.
+func (mr *MockIServiceMockRecorder) GetPerpsAccountTokenAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerpsAccountTokenAddress", reflect.TypeOf((*MockIService)(nil).GetPerpsAccountTokenAddress))
+}
+
+// GetPoolConfiguration mocks base method.
+func (m *MockIService) GetPoolConfiguration(poolID *big.Int) ([]*models.PoolMarketConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolConfiguration", poolID)
+	ret0, _ := ret[0].([]*models.PoolMarketConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolConfiguration indicates an expected call of GetPoolConfiguration.
+func (mr *MockIServiceMockRecorder) GetPoolConfiguration(poolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolConfiguration", reflect.TypeOf((*MockIService)(nil).GetPoolConfiguration), poolID)
+}
+
+// GetPoolName mocks base method.
+func (m *MockIService) GetPoolName(poolID *big.Int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolName", poolID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolName indicates an expected call of GetPoolName.
+func (mr *MockIServiceMockRecorder) GetPoolName(poolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolName", reflect.TypeOf((*MockIService)(nil).GetPoolName), poolID)
+}
+
+// GetPoolOwner mocks base method.
+func (m *MockIService) GetPoolOwner(poolID *big.Int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolOwner", poolID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolOwner indicates an expected call of GetPoolOwner.
+func (mr *MockIServiceMockRecorder) GetPoolOwner(poolID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolOwner", reflect.TypeOf((*MockIService)(nil).GetPoolOwner), poolID)
+}
+
+// GetPosition mocks base method.
+func (m *MockIService) GetPosition(accountID, marketID *big.Int) (*models.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosition", accountID, marketID)
+	ret0, _ := ret[0].(*models.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPosition indicates an expected call of GetPosition.
+func (mr *MockIServiceMockRecorder) GetPosition(accountID, marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosition", reflect.TypeOf((*MockIService)(nil).GetPosition), accountID, marketID)
+}
+
+// GetPositionCollateral mocks base method.
+func (m *MockIService) GetPositionCollateral(accountID, poolID *big.Int, collateralType common.Address) (*models.PositionCollateral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositionCollateral", accountID, poolID, collateralType)
+	ret0, _ := ret[0].(*models.PositionCollateral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositionCollateral indicates an expected call of GetPositionCollateral.
+func (mr *MockIServiceMockRecorder) GetPositionCollateral(accountID, poolID, collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositionCollateral", reflect.TypeOf((*MockIService)(nil).GetPositionCollateral), accountID, poolID, collateralType)
+}
+
+// GetPositionDebt mocks base method.
+func (m *MockIService) GetPositionDebt(accountID, poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositionDebt", accountID, poolID, collateralType)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositionDebt indicates an expected call of GetPositionDebt.
+func (mr *MockIServiceMockRecorder) GetPositionDebt(accountID, poolID, collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositionDebt", reflect.TypeOf((*MockIService)(nil).GetPositionDebt), accountID, poolID, collateralType)
+}
+
+// GetPreferredPool mocks base method.
+func (m *MockIService) GetPreferredPool() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferredPool")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferredPool indicates an expected call of GetPreferredPool.
+func (mr *MockIServiceMockRecorder) GetPreferredPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferredPool", reflect.TypeOf((*MockIService)(nil).GetPreferredPool))
+}
+
+// GetQuoteBuyExactIn mocks base method.
+func (m *MockIService) GetQuoteBuyExactIn(synthMarketID, usdAmount *big.Int) (*models.SpotQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuoteBuyExactIn", synthMarketID, usdAmount)
+	ret0, _ := ret[0].(*models.SpotQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuoteBuyExactIn indicates an expected call of GetQuoteBuyExactIn.
+func (mr *MockIServiceMockRecorder) GetQuoteBuyExactIn(synthMarketID, usdAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuoteBuyExactIn", reflect.TypeOf((*MockIService)(nil).GetQuoteBuyExactIn), synthMarketID, usdAmount)
+}
+
+// GetQuoteBuyExactOut mocks base method.
+func (m *MockIService) GetQuoteBuyExactOut(synthMarketID, synthAmount *big.Int) (*models.SpotQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuoteBuyExactOut", synthMarketID, synthAmount)
+	ret0, _ := ret[0].(*models.SpotQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuoteBuyExactOut indicates an expected call of GetQuoteBuyExactOut.
+func (mr *MockIServiceMockRecorder) GetQuoteBuyExactOut(synthMarketID, synthAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuoteBuyExactOut", reflect.TypeOf((*MockIService)(nil).GetQuoteBuyExactOut), synthMarketID, synthAmount)
+}
+
+// GetQuoteSellExactIn mocks base method.
+func (m *MockIService) GetQuoteSellExactIn(synthMarketID, synthAmount *big.Int) (*models.SpotQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuoteSellExactIn", synthMarketID, synthAmount)
+	ret0, _ := ret[0].(*models.SpotQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuoteSellExactIn indicates an expected call of GetQuoteSellExactIn.
+func (mr *MockIServiceMockRecorder) GetQuoteSellExactIn(synthMarketID, synthAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuoteSellExactIn", reflect.TypeOf((*MockIService)(nil).GetQuoteSellExactIn), synthMarketID, synthAmount)
+}
+
+// GetQuoteSellExactOut mocks base method.
+func (m *MockIService) GetQuoteSellExactOut(synthMarketID, usdAmount *big.Int) (*models.SpotQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuoteSellExactOut", synthMarketID, usdAmount)
+	ret0, _ := ret[0].(*models.SpotQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuoteSellExactOut indicates an expected call of GetQuoteSellExactOut.
+func (mr *MockIServiceMockRecorder) GetQuoteSellExactOut(synthMarketID, usdAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuoteSellExactOut", reflect.TypeOf((*MockIService)(nil).GetQuoteSellExactOut), synthMarketID, usdAmount)
+}
+
+// GetRequiredMaintenanceMargin mocks base method.
+func (m *MockIService) GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequiredMaintenanceMargin", accountId)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequiredMaintenanceMargin indicates an expected call of GetRequiredMaintenanceMargin.
+func (mr *MockIServiceMockRecorder) GetRequiredMaintenanceMargin(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequiredMaintenanceMargin", reflect.TypeOf((*MockIService)(nil).GetRequiredMaintenanceMargin), accountId)
+}
+
+// GetRequiredMarginForOrder mocks base method.
+func (m *MockIService) GetRequiredMarginForOrder(accountId, marketId, sizeDelta *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequiredMarginForOrder", accountId, marketId, sizeDelta)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequiredMarginForOrder indicates an expected call of GetRequiredMarginForOrder.
+func (mr *MockIServiceMockRecorder) GetRequiredMarginForOrder(accountId, marketId, sizeDelta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequiredMarginForOrder", reflect.TypeOf((*MockIService)(nil).GetRequiredMarginForOrder), accountId, marketId, sizeDelta)
+}
+
+// GetRequiredMargins mocks base method.
+func (m *MockIService) GetRequiredMargins(accountId *big.Int) (*models.RequiredMargins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequiredMargins", accountId)
+	ret0, _ := ret[0].(*models.RequiredMargins)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequiredMargins indicates an expected call of GetRequiredMargins.
+func (mr *MockIServiceMockRecorder) GetRequiredMargins(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequiredMargins", reflect.TypeOf((*MockIService)(nil).GetRequiredMargins), accountId)
+}
+
+// GetSettlementStrategy mocks base method.
+func (m *MockIService) GetSettlementStrategy(marketId, strategyId *big.Int) (*models.SettlementStrategy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementStrategy", marketId, strategyId)
+	ret0, _ := ret[0].(*models.SettlementStrategy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementStrategy indicates an expected call of GetSettlementStrategy.
+func (mr *MockIServiceMockRecorder) GetSettlementStrategy(marketId, strategyId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementStrategy", reflect.TypeOf((*MockIService)(nil).GetSettlementStrategy), marketId, strategyId)
+}
+
+// GetSpotMarketFees mocks base method.
+func (m *MockIService) GetSpotMarketFees(synthMarketID *big.Int) (*models.SpotMarketFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpotMarketFees", synthMarketID)
+	ret0, _ := ret[0].(*models.SpotMarketFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpotMarketFees indicates an expected call of GetSpotMarketFees.
+func (mr *MockIServiceMockRecorder) GetSpotMarketFees(synthMarketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotMarketFees", reflect.TypeOf((*MockIService)(nil).GetSpotMarketFees), synthMarketID)
+}
+
+// GetSpotSettlementStrategy mocks base method.
+func (m *MockIService) GetSpotSettlementStrategy(synthMarketID, strategyID *big.Int) (*models.SpotSettlementStrategy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpotSettlementStrategy", synthMarketID, strategyID)
+	ret0, _ := ret[0].(*models.SpotSettlementStrategy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpotSettlementStrategy indicates an expected call of GetSpotSettlementStrategy.
+func (mr *MockIServiceMockRecorder) GetSpotSettlementStrategy(synthMarketID, strategyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotSettlementStrategy", reflect.TypeOf((*MockIService)(nil).GetSpotSettlementStrategy), synthMarketID, strategyID)
+}
+
+// GetSupportedCollaterals mocks base method.
+func (m *MockIService) GetSupportedCollaterals() ([]*models.PerpsCollateralConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportedCollaterals")
+	ret0, _ := ret[0].([]*models.PerpsCollateralConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportedCollaterals indicates an expected call of GetSupportedCollaterals.
+func (mr *MockIServiceMockRecorder) GetSupportedCollaterals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedCollaterals", reflect.TypeOf((*MockIService)(nil).GetSupportedCollaterals))
+}
+
+// GetSynthAddress mocks base method.
+func (m *MockIService) GetSynthAddress(synthMarketID *big.Int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSynthAddress", synthMarketID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSynthAddress indicates an expected call of GetSynthAddress.
+func (mr *MockIServiceMockRecorder) GetSynthAddress(synthMarketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSynthAddress", reflect.TypeOf((*MockIService)(nil).GetSynthAddress), synthMarketID)
+}
+
+// GetTotalCollateralValue mocks base method.
+func (m *MockIService) GetTotalCollateralValue(accountId *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCollateralValue", accountId)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalCollateralValue indicates an expected call of GetTotalCollateralValue.
+func (mr *MockIServiceMockRecorder) GetTotalCollateralValue(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCollateralValue", reflect.TypeOf((*MockIService)(nil).GetTotalCollateralValue), accountId)
+}
+
+// GetUsdTokenAddress mocks base method.
+func (m *MockIService) GetUsdTokenAddress() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsdTokenAddress")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsdTokenAddress indicates an expected call of GetUsdTokenAddress.
+func (mr *MockIServiceMockRecorder) GetUsdTokenAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsdTokenAddress", reflect.TypeOf((*MockIService)(nil).GetUsdTokenAddress))
+}
+
+// GetVaultCollateral mocks base method.
+func (m *MockIService) GetVaultCollateral(poolID *big.Int, collateralType common.Address) (*models.VaultCollateral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVaultCollateral", poolID, collateralType)
+	ret0, _ := ret[0].(*models.VaultCollateral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVaultCollateral indicates an expected call of GetVaultCollateral.
+func (mr *MockIServiceMockRecorder) GetVaultCollateral(poolID, collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVaultCollateral", reflect.TypeOf((*MockIService)(nil).GetVaultCollateral), poolID, collateralType)
+}
+
+// GetVaultCollateralRatio mocks base method.
+func (m *MockIService) GetVaultCollateralRatio(poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVaultCollateralRatio", poolID, collateralType)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVaultCollateralRatio indicates an expected call of GetVaultCollateralRatio.
+func (mr *MockIServiceMockRecorder) GetVaultCollateralRatio(poolID, collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVaultCollateralRatio", reflect.TypeOf((*MockIService)(nil).GetVaultCollateralRatio), poolID, collateralType)
+}
+
+// GetVaultDebt mocks base method.
+func (m *MockIService) GetVaultDebt(poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVaultDebt", poolID, collateralType)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVaultDebt indicates an expected call of GetVaultDebt.
+func (mr *MockIServiceMockRecorder) GetVaultDebt(poolID, collateralType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVaultDebt", reflect.TypeOf((*MockIService)(nil).GetVaultDebt), poolID, collateralType)
+}
+
+// GetWithdrawableMargin mocks base method.
+func (m *MockIService) GetWithdrawableMargin(accountId *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawableMargin", accountId)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawableMargin indicates an expected call of GetWithdrawableMargin.
+func (mr *MockIServiceMockRecorder) GetWithdrawableMargin(accountId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawableMargin", reflect.TypeOf((*MockIService)(nil).GetWithdrawableMargin), accountId)
+}
+
+// GetWithdrawableMarketUsd mocks base method.
+func (m *MockIService) GetWithdrawableMarketUsd(marketID *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawableMarketUsd", marketID)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawableMarketUsd indicates an expected call of GetWithdrawableMarketUsd.
+func (mr *MockIServiceMockRecorder) GetWithdrawableMarketUsd(marketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawableMarketUsd", reflect.TypeOf((*MockIService)(nil).GetWithdrawableMarketUsd), marketID)
+}
+
+// HasPermission mocks base method.
+func (m *MockIService) HasPermission(accountId *big.Int, user, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", accountId, user, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockIServiceMockRecorder) HasPermission(accountId, user, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockIService)(nil).HasPermission), accountId, user, permission)
+}
+
+// RetrieveAccountLiquidationAttempts mocks base method.
+func (m *MockIService) RetrieveAccountLiquidationAttempts(fromBlock uint64, toBLock *uint64) ([]*models.AccountLiquidationAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveAccountLiquidationAttempts", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.AccountLiquidationAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveAccountLiquidationAttempts indicates an expected call of RetrieveAccountLiquidationAttempts.
+func (mr *MockIServiceMockRecorder) RetrieveAccountLiquidationAttempts(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveAccountLiquidationAttempts", reflect.TypeOf((*MockIService)(nil).RetrieveAccountLiquidationAttempts), fromBlock, toBLock)
+}
+
+// RetrieveAccountLiquidationAttemptsLimit mocks base method.
+func (m *MockIService) RetrieveAccountLiquidationAttemptsLimit(limit uint64) ([]*models.AccountLiquidationAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveAccountLiquidationAttemptsLimit", limit)
+	ret0, _ := ret[0].([]*models.AccountLiquidationAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveAccountLiquidationAttemptsLimit indicates an expected call of RetrieveAccountLiquidationAttemptsLimit.
+func (mr *MockIServiceMockRecorder) RetrieveAccountLiquidationAttemptsLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveAccountLiquidationAttemptsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveAccountLiquidationAttemptsLimit), limit)
+}
+
+// RetrieveAccountLiquidationsLimit mocks base method.
+func (m *MockIService) RetrieveAccountLiquidationsLimit(limit uint64) ([]*models.AccountLiquidated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveAccountLiquidationsLimit", limit)
+	ret0, _ := ret[0].([]*models.AccountLiquidated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveAccountLiquidationsLimit indicates an expected call of RetrieveAccountLiquidationsLimit.
+func (mr *MockIServiceMockRecorder) RetrieveAccountLiquidationsLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveAccountLiquidationsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveAccountLiquidationsLimit), limit)
+}
+
+// RetrieveAccountsCreated mocks base method.
+func (m *MockIService) RetrieveAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.AccountCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveAccountsCreated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.AccountCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveAccountsCreated indicates an expected call of RetrieveAccountsCreated.
+func (mr *MockIServiceMockRecorder) RetrieveAccountsCreated(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveAccountsCreated", reflect.TypeOf((*MockIService)(nil).RetrieveAccountsCreated), fromBlock, toBLock)
+}
+
+// RetrieveAccountsCreatedLimit mocks base method.
+func (m *MockIService) RetrieveAccountsCreatedLimit(limit uint64) ([]*models.AccountCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveAccountsCreatedLimit", limit)
+	ret0, _ := ret[0].([]*models.AccountCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveAccountsCreatedLimit indicates an expected call of RetrieveAccountsCreatedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveAccountsCreatedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveAccountsCreatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveAccountsCreatedLimit), limit)
+}
+
+// RetrieveCollateralConfigured mocks base method.
+func (m *MockIService) RetrieveCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.CollateralConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralConfigured", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CollateralConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralConfigured indicates an expected call of RetrieveCollateralConfigured.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralConfigured(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralConfigured", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralConfigured), fromBlock, toBLock)
+}
+
+// RetrieveCollateralConfiguredLimit mocks base method.
+func (m *MockIService) RetrieveCollateralConfiguredLimit(limit uint64) ([]*models.CollateralConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralConfiguredLimit", limit)
+	ret0, _ := ret[0].([]*models.CollateralConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralConfiguredLimit indicates an expected call of RetrieveCollateralConfiguredLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralConfiguredLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralConfiguredLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralConfiguredLimit), limit)
+}
+
+// RetrieveCollateralDeposited mocks base method.
+func (m *MockIService) RetrieveCollateralDeposited(fromBlock uint64, toBLock *uint64) ([]*models.CollateralDeposited, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralDeposited", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CollateralDeposited)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralDeposited indicates an expected call of RetrieveCollateralDeposited.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralDeposited(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralDeposited", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralDeposited), fromBlock, toBLock)
+}
+
+// RetrieveCollateralDepositedLimit mocks base method.
+func (m *MockIService) RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralDepositedLimit", limit)
+	ret0, _ := ret[0].([]*models.CollateralDeposited)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralDepositedLimit indicates an expected call of RetrieveCollateralDepositedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralDepositedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralDepositedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralDepositedLimit), limit)
+}
+
+// RetrieveCollateralLockChanges mocks base method.
+func (m *MockIService) RetrieveCollateralLockChanges(fromBlock uint64, toBLock *uint64) ([]*models.CollateralLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralLockChanges", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CollateralLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralLockChanges indicates an expected call of RetrieveCollateralLockChanges.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralLockChanges(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralLockChanges", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralLockChanges), fromBlock, toBLock)
+}
+
+// RetrieveCollateralLockChangesLimit mocks base method.
+func (m *MockIService) RetrieveCollateralLockChangesLimit(limit uint64) ([]*models.CollateralLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralLockChangesLimit", limit)
+	ret0, _ := ret[0].([]*models.CollateralLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralLockChangesLimit indicates an expected call of RetrieveCollateralLockChangesLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralLockChangesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralLockChangesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralLockChangesLimit), limit)
+}
+
+// RetrieveCollateralModified mocks base method.
+func (m *MockIService) RetrieveCollateralModified(fromBlock uint64, toBLock *uint64) ([]*models.CollateralModified, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralModified", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CollateralModified)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralModified indicates an expected call of RetrieveCollateralModified.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralModified(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralModified", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralModified), fromBlock, toBLock)
+}
+
+// RetrieveCollateralModifiedLimit mocks base method.
+func (m *MockIService) RetrieveCollateralModifiedLimit(limit uint64) ([]*models.CollateralModified, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralModifiedLimit", limit)
+	ret0, _ := ret[0].([]*models.CollateralModified)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralModifiedLimit indicates an expected call of RetrieveCollateralModifiedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralModifiedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralModifiedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralModifiedLimit), limit)
+}
+
+// RetrieveCollateralWithdrawn mocks base method.
+func (m *MockIService) RetrieveCollateralWithdrawn(fromBlock uint64, toBLock *uint64) ([]*models.CollateralWithdrawn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralWithdrawn", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CollateralWithdrawn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralWithdrawn indicates an expected call of RetrieveCollateralWithdrawn.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralWithdrawn(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralWithdrawn", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralWithdrawn), fromBlock, toBLock)
+}
+
+// RetrieveCollateralWithdrawnLimit mocks base method.
+func (m *MockIService) RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCollateralWithdrawnLimit", limit)
+	ret0, _ := ret[0].([]*models.CollateralWithdrawn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCollateralWithdrawnLimit indicates an expected call of RetrieveCollateralWithdrawnLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCollateralWithdrawnLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCollateralWithdrawnLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCollateralWithdrawnLimit), limit)
+}
+
+// RetrieveCoreAccountsCreated mocks base method.
+func (m *MockIService) RetrieveCoreAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.CoreAccountCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCoreAccountsCreated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CoreAccountCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCoreAccountsCreated indicates an expected call of RetrieveCoreAccountsCreated.
+func (mr *MockIServiceMockRecorder) RetrieveCoreAccountsCreated(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCoreAccountsCreated", reflect.TypeOf((*MockIService)(nil).RetrieveCoreAccountsCreated), fromBlock, toBLock)
+}
+
+// RetrieveCoreAccountsCreatedLimit mocks base method.
+func (m *MockIService) RetrieveCoreAccountsCreatedLimit(limit uint64) ([]*models.CoreAccountCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCoreAccountsCreatedLimit", limit)
+	ret0, _ := ret[0].([]*models.CoreAccountCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCoreAccountsCreatedLimit indicates an expected call of RetrieveCoreAccountsCreatedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCoreAccountsCreatedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCoreAccountsCreatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCoreAccountsCreatedLimit), limit)
+}
+
+// RetrieveCoreLiquidations mocks base method.
+func (m *MockIService) RetrieveCoreLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.CoreLiquidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCoreLiquidations", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.CoreLiquidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCoreLiquidations indicates an expected call of RetrieveCoreLiquidations.
+func (mr *MockIServiceMockRecorder) RetrieveCoreLiquidations(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCoreLiquidations", reflect.TypeOf((*MockIService)(nil).RetrieveCoreLiquidations), fromBlock, toBLock)
+}
+
+// RetrieveCoreLiquidationsLimit mocks base method.
+func (m *MockIService) RetrieveCoreLiquidationsLimit(limit uint64) ([]*models.CoreLiquidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveCoreLiquidationsLimit", limit)
+	ret0, _ := ret[0].([]*models.CoreLiquidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveCoreLiquidationsLimit indicates an expected call of RetrieveCoreLiquidationsLimit.
+func (mr *MockIServiceMockRecorder) RetrieveCoreLiquidationsLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveCoreLiquidationsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveCoreLiquidationsLimit), limit)
+}
+
+// RetrieveDelegationUpdated mocks base method.
+func (m *MockIService) RetrieveDelegationUpdated(fromBlock uint64, toBLock *uint64) ([]*models.DelegationUpdated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveDelegationUpdated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.DelegationUpdated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveDelegationUpdated indicates an expected call of RetrieveDelegationUpdated.
+func (mr *MockIServiceMockRecorder) RetrieveDelegationUpdated(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveDelegationUpdated", reflect.TypeOf((*MockIService)(nil).RetrieveDelegationUpdated), fromBlock, toBLock)
+}
+
+// RetrieveDelegationUpdatedLimit mocks base method.
+func (m *MockIService) RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveDelegationUpdatedLimit", limit)
+	ret0, _ := ret[0].([]*models.DelegationUpdated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveDelegationUpdatedLimit indicates an expected call of RetrieveDelegationUpdatedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveDelegationUpdatedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveDelegationUpdatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveDelegationUpdatedLimit), limit)
+}
+
+// RetrieveFeeCollectorSet mocks base method.
+func (m *MockIService) RetrieveFeeCollectorSet(fromBlock uint64, toBLock *uint64) ([]*models.FeeCollector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveFeeCollectorSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.FeeCollector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveFeeCollectorSet indicates an expected call of RetrieveFeeCollectorSet.
+func (mr *MockIServiceMockRecorder) RetrieveFeeCollectorSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveFeeCollectorSet", reflect.TypeOf((*MockIService)(nil).RetrieveFeeCollectorSet), fromBlock, toBLock)
+}
+
+// RetrieveFeeCollectorSetLimit mocks base method.
+func (m *MockIService) RetrieveFeeCollectorSetLimit(limit uint64) ([]*models.FeeCollector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveFeeCollectorSetLimit", limit)
+	ret0, _ := ret[0].([]*models.FeeCollector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveFeeCollectorSetLimit indicates an expected call of RetrieveFeeCollectorSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveFeeCollectorSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveFeeCollectorSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveFeeCollectorSetLimit), limit)
+}
+
+// RetrieveFundingParametersSet mocks base method.
+func (m *MockIService) RetrieveFundingParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.FundingParameters, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveFundingParametersSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.FundingParameters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveFundingParametersSet indicates an expected call of RetrieveFundingParametersSet.
+func (mr *MockIServiceMockRecorder) RetrieveFundingParametersSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveFundingParametersSet", reflect.TypeOf((*MockIService)(nil).RetrieveFundingParametersSet), fromBlock, toBLock)
+}
+
+// RetrieveFundingParametersSetLimit mocks base method.
+func (m *MockIService) RetrieveFundingParametersSetLimit(limit uint64) ([]*models.FundingParameters, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveFundingParametersSetLimit", limit)
+	ret0, _ := ret[0].([]*models.FundingParameters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveFundingParametersSetLimit indicates an expected call of RetrieveFundingParametersSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveFundingParametersSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveFundingParametersSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveFundingParametersSetLimit), limit)
+}
+
+// RetrieveKeeperRewardGuardsSet mocks base method.
+func (m *MockIService) RetrieveKeeperRewardGuardsSet(fromBlock uint64, toBLock *uint64) ([]*models.KeeperRewardGuards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveKeeperRewardGuardsSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.KeeperRewardGuards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveKeeperRewardGuardsSet indicates an expected call of RetrieveKeeperRewardGuardsSet.
+func (mr *MockIServiceMockRecorder) RetrieveKeeperRewardGuardsSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveKeeperRewardGuardsSet", reflect.TypeOf((*MockIService)(nil).RetrieveKeeperRewardGuardsSet), fromBlock, toBLock)
+}
+
+// RetrieveKeeperRewardGuardsSetLimit mocks base method.
+func (m *MockIService) RetrieveKeeperRewardGuardsSetLimit(limit uint64) ([]*models.KeeperRewardGuards, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveKeeperRewardGuardsSetLimit", limit)
+	ret0, _ := ret[0].([]*models.KeeperRewardGuards)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveKeeperRewardGuardsSetLimit indicates an expected call of RetrieveKeeperRewardGuardsSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveKeeperRewardGuardsSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveKeeperRewardGuardsSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveKeeperRewardGuardsSetLimit), limit)
+}
+
+// RetrieveLiquidationParametersSet mocks base method.
+func (m *MockIService) RetrieveLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.LiquidationParameters, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveLiquidationParametersSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.LiquidationParameters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveLiquidationParametersSet indicates an expected call of RetrieveLiquidationParametersSet.
+func (mr *MockIServiceMockRecorder) RetrieveLiquidationParametersSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveLiquidationParametersSet", reflect.TypeOf((*MockIService)(nil).RetrieveLiquidationParametersSet), fromBlock, toBLock)
+}
+
+// RetrieveLiquidationParametersSetLimit mocks base method.
+func (m *MockIService) RetrieveLiquidationParametersSetLimit(limit uint64) ([]*models.LiquidationParameters, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveLiquidationParametersSetLimit", limit)
+	ret0, _ := ret[0].([]*models.LiquidationParameters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveLiquidationParametersSetLimit indicates an expected call of RetrieveLiquidationParametersSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveLiquidationParametersSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveLiquidationParametersSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveLiquidationParametersSetLimit), limit)
+}
+
+// RetrieveLiquidations mocks base method.
+func (m *MockIService) RetrieveLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.Liquidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveLiquidations", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.Liquidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveLiquidations indicates an expected call of RetrieveLiquidations.
+func (mr *MockIServiceMockRecorder) RetrieveLiquidations(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveLiquidations", reflect.TypeOf((*MockIService)(nil).RetrieveLiquidations), fromBlock, toBLock)
+}
+
+// RetrieveLiquidationsLimit mocks base method.
+func (m *MockIService) RetrieveLiquidationsLimit(limit uint64) ([]*models.Liquidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveLiquidationsLimit", limit)
+	ret0, _ := ret[0].([]*models.Liquidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveLiquidationsLimit indicates an expected call of RetrieveLiquidationsLimit.
+func (mr *MockIServiceMockRecorder) RetrieveLiquidationsLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveLiquidationsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveLiquidationsLimit), limit)
+}
+
+// RetrieveMarketCollateralChanges mocks base method.
+func (m *MockIService) RetrieveMarketCollateralChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketCollateralChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketCollateralChanges", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketCollateralChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketCollateralChanges indicates an expected call of RetrieveMarketCollateralChanges.
+func (mr *MockIServiceMockRecorder) RetrieveMarketCollateralChanges(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketCollateralChanges", reflect.TypeOf((*MockIService)(nil).RetrieveMarketCollateralChanges), fromBlock, toBLock)
+}
+
+// RetrieveMarketCollateralChangesLimit mocks base method.
+func (m *MockIService) RetrieveMarketCollateralChangesLimit(limit uint64) ([]*models.MarketCollateralChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketCollateralChangesLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketCollateralChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketCollateralChangesLimit indicates an expected call of RetrieveMarketCollateralChangesLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketCollateralChangesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketCollateralChangesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketCollateralChangesLimit), limit)
+}
+
+// RetrieveMarketPriceDataUpdated mocks base method.
+func (m *MockIService) RetrieveMarketPriceDataUpdated(fromBlock uint64, toBLock *uint64) ([]*models.MarketPriceData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketPriceDataUpdated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketPriceData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketPriceDataUpdated indicates an expected call of RetrieveMarketPriceDataUpdated.
+func (mr *MockIServiceMockRecorder) RetrieveMarketPriceDataUpdated(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketPriceDataUpdated", reflect.TypeOf((*MockIService)(nil).RetrieveMarketPriceDataUpdated), fromBlock, toBLock)
+}
+
+// RetrieveMarketPriceDataUpdatedLimit mocks base method.
+func (m *MockIService) RetrieveMarketPriceDataUpdatedLimit(limit uint64) ([]*models.MarketPriceData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketPriceDataUpdatedLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketPriceData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketPriceDataUpdatedLimit indicates an expected call of RetrieveMarketPriceDataUpdatedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketPriceDataUpdatedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketPriceDataUpdatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketPriceDataUpdatedLimit), limit)
+}
+
+// RetrieveMarketUSDChanges mocks base method.
+func (m *MockIService) RetrieveMarketUSDChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketUSDChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUSDChanges", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketUSDChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUSDChanges indicates an expected call of RetrieveMarketUSDChanges.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUSDChanges(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUSDChanges", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUSDChanges), fromBlock, toBLock)
+}
+
+// RetrieveMarketUSDChangesLimit mocks base method.
+func (m *MockIService) RetrieveMarketUSDChangesLimit(limit uint64) ([]*models.MarketUSDChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUSDChangesLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketUSDChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUSDChangesLimit indicates an expected call of RetrieveMarketUSDChangesLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUSDChangesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUSDChangesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUSDChangesLimit), limit)
+}
+
+// RetrieveMarketUSDDepositedLimit mocks base method.
+func (m *MockIService) RetrieveMarketUSDDepositedLimit(limit uint64) ([]*models.MarketUSDDeposited, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUSDDepositedLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketUSDDeposited)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUSDDepositedLimit indicates an expected call of RetrieveMarketUSDDepositedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUSDDepositedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUSDDepositedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUSDDepositedLimit), limit)
+}
+
+// RetrieveMarketUSDWithdrawnLimit mocks base method.
+func (m *MockIService) RetrieveMarketUSDWithdrawnLimit(limit uint64) ([]*models.MarketUSDWithdrawn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUSDWithdrawnLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketUSDWithdrawn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUSDWithdrawnLimit indicates an expected call of RetrieveMarketUSDWithdrawnLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUSDWithdrawnLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUSDWithdrawnLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUSDWithdrawnLimit), limit)
+}
+
+// RetrieveMarketUpdates mocks base method.
+func (m *MockIService) RetrieveMarketUpdates(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUpdates", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUpdates indicates an expected call of RetrieveMarketUpdates.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUpdates(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdates", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdates), fromBlock, toBLock)
+}
+
+// RetrieveMarketUpdatesBig mocks base method.
+func (m *MockIService) RetrieveMarketUpdatesBig(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdateBig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUpdatesBig", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketUpdateBig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -393,67 +1803,532 @@ func (m *MockIService) RetrieveMarketUpdatesBig(fromBlock uint64, toBLock *uint6
 // RetrieveMarketUpdatesBig indicates an expected call of RetrieveMarketUpdatesBig.
 func (mr *MockIServiceMockRecorder) RetrieveMarketUpdatesBig(fromBlock, toBLock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdatesBig", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdatesBig), fromBlock, toBLock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdatesBig", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdatesBig), fromBlock, toBLock)
+}
+
+// RetrieveMarketUpdatesBigLimit mocks base method.
+func (m *MockIService) RetrieveMarketUpdatesBigLimit(limit uint64) ([]*models.MarketUpdateBig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUpdatesBigLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketUpdateBig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUpdatesBigLimit indicates an expected call of RetrieveMarketUpdatesBigLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUpdatesBigLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdatesBigLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdatesBigLimit), limit)
+}
+
+// RetrieveMarketUpdatesLimit mocks base method.
+func (m *MockIService) RetrieveMarketUpdatesLimit(limit uint64) ([]*models.MarketUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketUpdatesLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketUpdatesLimit indicates an expected call of RetrieveMarketUpdatesLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketUpdatesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdatesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdatesLimit), limit)
 }
 
-// RetrieveMarketUpdatesBigLimit mocks base method.
-func (m *MockIService) RetrieveMarketUpdatesBigLimit(limit uint64) ([]*models.MarketUpdateBig, error) {
+// RetrieveMarketsCreated mocks base method.
+func (m *MockIService) RetrieveMarketsCreated(fromBlock uint64, toBLock *uint64) ([]*models.MarketCreated, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveMarketUpdatesBigLimit", limit)
-	ret0, _ := ret[0].([]*models.MarketUpdateBig)
+	ret := m.ctrl.Call(m, "RetrieveMarketsCreated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketCreated)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveMarketUpdatesBigLimit indicates an expected call of RetrieveMarketUpdatesBigLimit.
-func (mr *MockIServiceMockRecorder) RetrieveMarketUpdatesBigLimit(limit interface{}) *gomock.Call {
+// RetrieveMarketsCreated indicates an expected call of RetrieveMarketsCreated.
+func (mr *MockIServiceMockRecorder) RetrieveMarketsCreated(fromBlock, toBLock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdatesBigLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdatesBigLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketsCreated", reflect.TypeOf((*MockIService)(nil).RetrieveMarketsCreated), fromBlock, toBLock)
 }
 
-// RetrieveMarketUpdatesLimit mocks base method.
-func (m *MockIService) RetrieveMarketUpdatesLimit(limit uint64) ([]*models.MarketUpdate, error) {
+// RetrieveMarketsCreatedLimit mocks base method.
+func (m *MockIService) RetrieveMarketsCreatedLimit(limit uint64) ([]*models.MarketCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketsCreatedLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketsCreatedLimit indicates an expected call of RetrieveMarketsCreatedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketsCreatedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketsCreatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketsCreatedLimit), limit)
+}
+
+// RetrieveMarketsRegistered mocks base method.
+func (m *MockIService) RetrieveMarketsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.MarketRegistered, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketsRegistered", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MarketRegistered)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketsRegistered indicates an expected call of RetrieveMarketsRegistered.
+func (mr *MockIServiceMockRecorder) RetrieveMarketsRegistered(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketsRegistered", reflect.TypeOf((*MockIService)(nil).RetrieveMarketsRegistered), fromBlock, toBLock)
+}
+
+// RetrieveMarketsRegisteredLimit mocks base method.
+func (m *MockIService) RetrieveMarketsRegisteredLimit(limit uint64) ([]*models.MarketRegistered, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMarketsRegisteredLimit", limit)
+	ret0, _ := ret[0].([]*models.MarketRegistered)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMarketsRegisteredLimit indicates an expected call of RetrieveMarketsRegisteredLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMarketsRegisteredLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketsRegisteredLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketsRegisteredLimit), limit)
+}
+
+// RetrieveMaxLiquidationParametersSet mocks base method.
+func (m *MockIService) RetrieveMaxLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxLiquidationParameters, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMaxLiquidationParametersSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MaxLiquidationParameters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMaxLiquidationParametersSet indicates an expected call of RetrieveMaxLiquidationParametersSet.
+func (mr *MockIServiceMockRecorder) RetrieveMaxLiquidationParametersSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMaxLiquidationParametersSet", reflect.TypeOf((*MockIService)(nil).RetrieveMaxLiquidationParametersSet), fromBlock, toBLock)
+}
+
+// RetrieveMaxLiquidationParametersSetLimit mocks base method.
+func (m *MockIService) RetrieveMaxLiquidationParametersSetLimit(limit uint64) ([]*models.MaxLiquidationParameters, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMaxLiquidationParametersSetLimit", limit)
+	ret0, _ := ret[0].([]*models.MaxLiquidationParameters)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMaxLiquidationParametersSetLimit indicates an expected call of RetrieveMaxLiquidationParametersSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMaxLiquidationParametersSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMaxLiquidationParametersSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMaxLiquidationParametersSetLimit), limit)
+}
+
+// RetrieveMaxMarketSizesSet mocks base method.
+func (m *MockIService) RetrieveMaxMarketSizesSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxMarketSize, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMaxMarketSizesSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.MaxMarketSize)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMaxMarketSizesSet indicates an expected call of RetrieveMaxMarketSizesSet.
+func (mr *MockIServiceMockRecorder) RetrieveMaxMarketSizesSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMaxMarketSizesSet", reflect.TypeOf((*MockIService)(nil).RetrieveMaxMarketSizesSet), fromBlock, toBLock)
+}
+
+// RetrieveMaxMarketSizesSetLimit mocks base method.
+func (m *MockIService) RetrieveMaxMarketSizesSetLimit(limit uint64) ([]*models.MaxMarketSize, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveMaxMarketSizesSetLimit", limit)
+	ret0, _ := ret[0].([]*models.MaxMarketSize)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveMaxMarketSizesSetLimit indicates an expected call of RetrieveMaxMarketSizesSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveMaxMarketSizesSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMaxMarketSizesSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMaxMarketSizesSetLimit), limit)
+}
+
+// RetrieveOrderFeesSet mocks base method.
+func (m *MockIService) RetrieveOrderFeesSet(fromBlock uint64, toBLock *uint64) ([]*models.OrderFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveOrderFeesSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.OrderFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveOrderFeesSet indicates an expected call of RetrieveOrderFeesSet.
+func (mr *MockIServiceMockRecorder) RetrieveOrderFeesSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrderFeesSet", reflect.TypeOf((*MockIService)(nil).RetrieveOrderFeesSet), fromBlock, toBLock)
+}
+
+// RetrieveOrderFeesSetLimit mocks base method.
+func (m *MockIService) RetrieveOrderFeesSetLimit(limit uint64) ([]*models.OrderFees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveOrderFeesSetLimit", limit)
+	ret0, _ := ret[0].([]*models.OrderFees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveOrderFeesSetLimit indicates an expected call of RetrieveOrderFeesSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveOrderFeesSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrderFeesSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveOrderFeesSetLimit), limit)
+}
+
+// RetrieveOrders mocks base method.
+func (m *MockIService) RetrieveOrders(fromBlock uint64, toBLock *uint64) ([]*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveOrders", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveOrders indicates an expected call of RetrieveOrders.
+func (mr *MockIServiceMockRecorder) RetrieveOrders(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrders", reflect.TypeOf((*MockIService)(nil).RetrieveOrders), fromBlock, toBLock)
+}
+
+// RetrieveOrdersCanceled mocks base method.
+func (m *MockIService) RetrieveOrdersCanceled(fromBlock uint64, toBLock *uint64) ([]*models.OrderCanceled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveOrdersCanceled", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.OrderCanceled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveOrdersCanceled indicates an expected call of RetrieveOrdersCanceled.
+func (mr *MockIServiceMockRecorder) RetrieveOrdersCanceled(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrdersCanceled", reflect.TypeOf((*MockIService)(nil).RetrieveOrdersCanceled), fromBlock, toBLock)
+}
+
+// RetrieveOrdersCanceledLimit mocks base method.
+func (m *MockIService) RetrieveOrdersCanceledLimit(limit uint64) ([]*models.OrderCanceled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveOrdersCanceledLimit", limit)
+	ret0, _ := ret[0].([]*models.OrderCanceled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveOrdersCanceledLimit indicates an expected call of RetrieveOrdersCanceledLimit.
+func (mr *MockIServiceMockRecorder) RetrieveOrdersCanceledLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrdersCanceledLimit", reflect.TypeOf((*MockIService)(nil).RetrieveOrdersCanceledLimit), limit)
+}
+
+// RetrieveOrdersLimit mocks base method.
+func (m *MockIService) RetrieveOrdersLimit(limit uint64) ([]*models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveOrdersLimit", limit)
+	ret0, _ := ret[0].([]*models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveOrdersLimit indicates an expected call of RetrieveOrdersLimit.
+func (mr *MockIServiceMockRecorder) RetrieveOrdersLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrdersLimit", reflect.TypeOf((*MockIService)(nil).RetrieveOrdersLimit), limit)
+}
+
+// RetrievePerAccountCapsSet mocks base method.
+func (m *MockIService) RetrievePerAccountCapsSet(fromBlock uint64, toBLock *uint64) ([]*models.PerAccountCaps, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePerAccountCapsSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PerAccountCaps)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePerAccountCapsSet indicates an expected call of RetrievePerAccountCapsSet.
+func (mr *MockIServiceMockRecorder) RetrievePerAccountCapsSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePerAccountCapsSet", reflect.TypeOf((*MockIService)(nil).RetrievePerAccountCapsSet), fromBlock, toBLock)
+}
+
+// RetrievePerAccountCapsSetLimit mocks base method.
+func (m *MockIService) RetrievePerAccountCapsSetLimit(limit uint64) ([]*models.PerAccountCaps, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePerAccountCapsSetLimit", limit)
+	ret0, _ := ret[0].([]*models.PerAccountCaps)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePerAccountCapsSetLimit indicates an expected call of RetrievePerAccountCapsSetLimit.
+func (mr *MockIServiceMockRecorder) RetrievePerAccountCapsSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePerAccountCapsSetLimit", reflect.TypeOf((*MockIService)(nil).RetrievePerAccountCapsSetLimit), limit)
+}
+
+// RetrievePermissionChanges mocks base method.
+func (m *MockIService) RetrievePermissionChanges(fromBlock uint64, toBLock *uint64) ([]*models.PermissionChanged, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePermissionChanges", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PermissionChanged)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePermissionChanges indicates an expected call of RetrievePermissionChanges.
+func (mr *MockIServiceMockRecorder) RetrievePermissionChanges(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePermissionChanges", reflect.TypeOf((*MockIService)(nil).RetrievePermissionChanges), fromBlock, toBLock)
+}
+
+// RetrievePermissionChangesLimit mocks base method.
+func (m *MockIService) RetrievePermissionChangesLimit(limit uint64) ([]*models.PermissionChanged, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePermissionChangesLimit", limit)
+	ret0, _ := ret[0].([]*models.PermissionChanged)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePermissionChangesLimit indicates an expected call of RetrievePermissionChangesLimit.
+func (mr *MockIServiceMockRecorder) RetrievePermissionChangesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePermissionChangesLimit", reflect.TypeOf((*MockIService)(nil).RetrievePermissionChangesLimit), limit)
+}
+
+// RetrievePerpsCollateralConfigured mocks base method.
+func (m *MockIService) RetrievePerpsCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.PerpsCollateralConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePerpsCollateralConfigured", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PerpsCollateralConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePerpsCollateralConfigured indicates an expected call of RetrievePerpsCollateralConfigured.
+func (mr *MockIServiceMockRecorder) RetrievePerpsCollateralConfigured(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePerpsCollateralConfigured", reflect.TypeOf((*MockIService)(nil).RetrievePerpsCollateralConfigured), fromBlock, toBLock)
+}
+
+// RetrievePerpsCollateralConfiguredLimit mocks base method.
+func (m *MockIService) RetrievePerpsCollateralConfiguredLimit(limit uint64) ([]*models.PerpsCollateralConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePerpsCollateralConfiguredLimit", limit)
+	ret0, _ := ret[0].([]*models.PerpsCollateralConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePerpsCollateralConfiguredLimit indicates an expected call of RetrievePerpsCollateralConfiguredLimit.
+func (mr *MockIServiceMockRecorder) RetrievePerpsCollateralConfiguredLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePerpsCollateralConfiguredLimit", reflect.TypeOf((*MockIService)(nil).RetrievePerpsCollateralConfiguredLimit), limit)
+}
+
+// RetrievePoolConfigurationsSet mocks base method.
+func (m *MockIService) RetrievePoolConfigurationsSet(fromBlock uint64, toBLock *uint64) ([]*models.PoolConfigurationSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolConfigurationsSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PoolConfigurationSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolConfigurationsSet indicates an expected call of RetrievePoolConfigurationsSet.
+func (mr *MockIServiceMockRecorder) RetrievePoolConfigurationsSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolConfigurationsSet", reflect.TypeOf((*MockIService)(nil).RetrievePoolConfigurationsSet), fromBlock, toBLock)
+}
+
+// RetrievePoolConfigurationsSetLimit mocks base method.
+func (m *MockIService) RetrievePoolConfigurationsSetLimit(limit uint64) ([]*models.PoolConfigurationSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolConfigurationsSetLimit", limit)
+	ret0, _ := ret[0].([]*models.PoolConfigurationSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolConfigurationsSetLimit indicates an expected call of RetrievePoolConfigurationsSetLimit.
+func (mr *MockIServiceMockRecorder) RetrievePoolConfigurationsSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolConfigurationsSetLimit", reflect.TypeOf((*MockIService)(nil).RetrievePoolConfigurationsSetLimit), limit)
+}
+
+// RetrievePoolNameUpdates mocks base method.
+func (m *MockIService) RetrievePoolNameUpdates(fromBlock uint64, toBLock *uint64) ([]*models.PoolNameUpdated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolNameUpdates", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PoolNameUpdated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolNameUpdates indicates an expected call of RetrievePoolNameUpdates.
+func (mr *MockIServiceMockRecorder) RetrievePoolNameUpdates(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolNameUpdates", reflect.TypeOf((*MockIService)(nil).RetrievePoolNameUpdates), fromBlock, toBLock)
+}
+
+// RetrievePoolNameUpdatesLimit mocks base method.
+func (m *MockIService) RetrievePoolNameUpdatesLimit(limit uint64) ([]*models.PoolNameUpdated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolNameUpdatesLimit", limit)
+	ret0, _ := ret[0].([]*models.PoolNameUpdated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolNameUpdatesLimit indicates an expected call of RetrievePoolNameUpdatesLimit.
+func (mr *MockIServiceMockRecorder) RetrievePoolNameUpdatesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolNameUpdatesLimit", reflect.TypeOf((*MockIService)(nil).RetrievePoolNameUpdatesLimit), limit)
+}
+
+// RetrievePoolOwnershipChanges mocks base method.
+func (m *MockIService) RetrievePoolOwnershipChanges(fromBlock uint64, toBLock *uint64) ([]*models.PoolOwnershipChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolOwnershipChanges", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PoolOwnershipChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolOwnershipChanges indicates an expected call of RetrievePoolOwnershipChanges.
+func (mr *MockIServiceMockRecorder) RetrievePoolOwnershipChanges(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolOwnershipChanges", reflect.TypeOf((*MockIService)(nil).RetrievePoolOwnershipChanges), fromBlock, toBLock)
+}
+
+// RetrievePoolOwnershipChangesLimit mocks base method.
+func (m *MockIService) RetrievePoolOwnershipChangesLimit(limit uint64) ([]*models.PoolOwnershipChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolOwnershipChangesLimit", limit)
+	ret0, _ := ret[0].([]*models.PoolOwnershipChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolOwnershipChangesLimit indicates an expected call of RetrievePoolOwnershipChangesLimit.
+func (mr *MockIServiceMockRecorder) RetrievePoolOwnershipChangesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolOwnershipChangesLimit", reflect.TypeOf((*MockIService)(nil).RetrievePoolOwnershipChangesLimit), limit)
+}
+
+// RetrievePoolsCreated mocks base method.
+func (m *MockIService) RetrievePoolsCreated(fromBlock uint64, toBLock *uint64) ([]*models.PoolCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolsCreated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.PoolCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolsCreated indicates an expected call of RetrievePoolsCreated.
+func (mr *MockIServiceMockRecorder) RetrievePoolsCreated(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolsCreated", reflect.TypeOf((*MockIService)(nil).RetrievePoolsCreated), fromBlock, toBLock)
+}
+
+// RetrievePoolsCreatedLimit mocks base method.
+func (m *MockIService) RetrievePoolsCreatedLimit(limit uint64) ([]*models.PoolCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePoolsCreatedLimit", limit)
+	ret0, _ := ret[0].([]*models.PoolCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePoolsCreatedLimit indicates an expected call of RetrievePoolsCreatedLimit.
+func (mr *MockIServiceMockRecorder) RetrievePoolsCreatedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePoolsCreatedLimit", reflect.TypeOf((*MockIService)(nil).RetrievePoolsCreatedLimit), limit)
+}
+
+// RetrievePreviousOrderExpired mocks base method.
+func (m *MockIService) RetrievePreviousOrderExpired(fromBlock uint64, toBLock *uint64) ([]*models.OrderExpired, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePreviousOrderExpired", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.OrderExpired)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePreviousOrderExpired indicates an expected call of RetrievePreviousOrderExpired.
+func (mr *MockIServiceMockRecorder) RetrievePreviousOrderExpired(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePreviousOrderExpired", reflect.TypeOf((*MockIService)(nil).RetrievePreviousOrderExpired), fromBlock, toBLock)
+}
+
+// RetrievePreviousOrderExpiredLimit mocks base method.
+func (m *MockIService) RetrievePreviousOrderExpiredLimit(limit uint64) ([]*models.OrderExpired, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePreviousOrderExpiredLimit", limit)
+	ret0, _ := ret[0].([]*models.OrderExpired)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePreviousOrderExpiredLimit indicates an expected call of RetrievePreviousOrderExpiredLimit.
+func (mr *MockIServiceMockRecorder) RetrievePreviousOrderExpiredLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePreviousOrderExpiredLimit", reflect.TypeOf((*MockIService)(nil).RetrievePreviousOrderExpiredLimit), limit)
+}
+
+// RetrieveReferrerSharesUpdated mocks base method.
+func (m *MockIService) RetrieveReferrerSharesUpdated(fromBlock uint64, toBLock *uint64) ([]*models.ReferrerShare, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveMarketUpdatesLimit", limit)
-	ret0, _ := ret[0].([]*models.MarketUpdate)
+	ret := m.ctrl.Call(m, "RetrieveReferrerSharesUpdated", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.ReferrerShare)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveMarketUpdatesLimit indicates an expected call of RetrieveMarketUpdatesLimit.
-func (mr *MockIServiceMockRecorder) RetrieveMarketUpdatesLimit(limit interface{}) *gomock.Call {
+// RetrieveReferrerSharesUpdated indicates an expected call of RetrieveReferrerSharesUpdated.
+func (mr *MockIServiceMockRecorder) RetrieveReferrerSharesUpdated(fromBlock, toBLock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveMarketUpdatesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveMarketUpdatesLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveReferrerSharesUpdated", reflect.TypeOf((*MockIService)(nil).RetrieveReferrerSharesUpdated), fromBlock, toBLock)
 }
 
-// RetrieveOrders mocks base method.
-func (m *MockIService) RetrieveOrders(fromBlock uint64, toBLock *uint64) ([]*models.Order, error) {
+// RetrieveReferrerSharesUpdatedLimit mocks base method.
+func (m *MockIService) RetrieveReferrerSharesUpdatedLimit(limit uint64) ([]*models.ReferrerShare, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveOrders", fromBlock, toBLock)
-	ret0, _ := ret[0].([]*models.Order)
+	ret := m.ctrl.Call(m, "RetrieveReferrerSharesUpdatedLimit", limit)
+	ret0, _ := ret[0].([]*models.ReferrerShare)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveOrders indicates an expected call of RetrieveOrders.
-func (mr *MockIServiceMockRecorder) RetrieveOrders(fromBlock, toBLock interface{}) *gomock.Call {
+// RetrieveReferrerSharesUpdatedLimit indicates an expected call of RetrieveReferrerSharesUpdatedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveReferrerSharesUpdatedLimit(limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrders", reflect.TypeOf((*MockIService)(nil).RetrieveOrders), fromBlock, toBLock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveReferrerSharesUpdatedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveReferrerSharesUpdatedLimit), limit)
 }
 
-// RetrieveOrdersLimit mocks base method.
-func (m *MockIService) RetrieveOrdersLimit(limit uint64) ([]*models.Order, error) {
+// RetrieveRewardClaimed mocks base method.
+func (m *MockIService) RetrieveRewardClaimed(fromBlock uint64, toBLock *uint64) ([]*models.RewardClaimed, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RetrieveOrdersLimit", limit)
-	ret0, _ := ret[0].([]*models.Order)
+	ret := m.ctrl.Call(m, "RetrieveRewardClaimed", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.RewardClaimed)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RetrieveOrdersLimit indicates an expected call of RetrieveOrdersLimit.
-func (mr *MockIServiceMockRecorder) RetrieveOrdersLimit(limit interface{}) *gomock.Call {
+// RetrieveRewardClaimed indicates an expected call of RetrieveRewardClaimed.
+func (mr *MockIServiceMockRecorder) RetrieveRewardClaimed(fromBlock, toBLock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveOrdersLimit", reflect.TypeOf((*MockIService)(nil).RetrieveOrdersLimit), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveRewardClaimed", reflect.TypeOf((*MockIService)(nil).RetrieveRewardClaimed), fromBlock, toBLock)
 }
 
 // RetrieveRewardClaimedLimit mocks base method.
@@ -471,6 +2346,21 @@ func (mr *MockIServiceMockRecorder) RetrieveRewardClaimedLimit(limit interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveRewardClaimedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveRewardClaimedLimit), limit)
 }
 
+// RetrieveRewardDistributed mocks base method.
+func (m *MockIService) RetrieveRewardDistributed(fromBlock uint64, toBLock *uint64) ([]*models.RewardDistributed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveRewardDistributed", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.RewardDistributed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveRewardDistributed indicates an expected call of RetrieveRewardDistributed.
+func (mr *MockIServiceMockRecorder) RetrieveRewardDistributed(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveRewardDistributed", reflect.TypeOf((*MockIService)(nil).RetrieveRewardDistributed), fromBlock, toBLock)
+}
+
 // RetrieveRewardDistributedLimit mocks base method.
 func (m *MockIService) RetrieveRewardDistributedLimit(limit uint64) ([]*models.RewardDistributed, error) {
 	m.ctrl.T.Helper()
@@ -486,6 +2376,336 @@ func (mr *MockIServiceMockRecorder) RetrieveRewardDistributedLimit(limit interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveRewardDistributedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveRewardDistributedLimit), limit)
 }
 
+// RetrieveSettlementStrategiesAdded mocks base method.
+func (m *MockIService) RetrieveSettlementStrategiesAdded(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyAdded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSettlementStrategiesAdded", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SettlementStrategyAdded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSettlementStrategiesAdded indicates an expected call of RetrieveSettlementStrategiesAdded.
+func (mr *MockIServiceMockRecorder) RetrieveSettlementStrategiesAdded(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSettlementStrategiesAdded", reflect.TypeOf((*MockIService)(nil).RetrieveSettlementStrategiesAdded), fromBlock, toBLock)
+}
+
+// RetrieveSettlementStrategiesAddedLimit mocks base method.
+func (m *MockIService) RetrieveSettlementStrategiesAddedLimit(limit uint64) ([]*models.SettlementStrategyAdded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSettlementStrategiesAddedLimit", limit)
+	ret0, _ := ret[0].([]*models.SettlementStrategyAdded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSettlementStrategiesAddedLimit indicates an expected call of RetrieveSettlementStrategiesAddedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSettlementStrategiesAddedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSettlementStrategiesAddedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSettlementStrategiesAddedLimit), limit)
+}
+
+// RetrieveSettlementStrategyUpdates mocks base method.
+func (m *MockIService) RetrieveSettlementStrategyUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSettlementStrategyUpdates", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SettlementStrategyUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSettlementStrategyUpdates indicates an expected call of RetrieveSettlementStrategyUpdates.
+func (mr *MockIServiceMockRecorder) RetrieveSettlementStrategyUpdates(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSettlementStrategyUpdates", reflect.TypeOf((*MockIService)(nil).RetrieveSettlementStrategyUpdates), fromBlock, toBLock)
+}
+
+// RetrieveSettlementStrategyUpdatesLimit mocks base method.
+func (m *MockIService) RetrieveSettlementStrategyUpdatesLimit(limit uint64) ([]*models.SettlementStrategyUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSettlementStrategyUpdatesLimit", limit)
+	ret0, _ := ret[0].([]*models.SettlementStrategyUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSettlementStrategyUpdatesLimit indicates an expected call of RetrieveSettlementStrategyUpdatesLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSettlementStrategyUpdatesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSettlementStrategyUpdatesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSettlementStrategyUpdatesLimit), limit)
+}
+
+// RetrieveSpotMarketFeeUpdates mocks base method.
+func (m *MockIService) RetrieveSpotMarketFeeUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SpotFeeUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotMarketFeeUpdates", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SpotFeeUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotMarketFeeUpdates indicates an expected call of RetrieveSpotMarketFeeUpdates.
+func (mr *MockIServiceMockRecorder) RetrieveSpotMarketFeeUpdates(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotMarketFeeUpdates", reflect.TypeOf((*MockIService)(nil).RetrieveSpotMarketFeeUpdates), fromBlock, toBLock)
+}
+
+// RetrieveSpotMarketFeeUpdatesLimit mocks base method.
+func (m *MockIService) RetrieveSpotMarketFeeUpdatesLimit(limit uint64) ([]*models.SpotFeeUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotMarketFeeUpdatesLimit", limit)
+	ret0, _ := ret[0].([]*models.SpotFeeUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotMarketFeeUpdatesLimit indicates an expected call of RetrieveSpotMarketFeeUpdatesLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSpotMarketFeeUpdatesLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotMarketFeeUpdatesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSpotMarketFeeUpdatesLimit), limit)
+}
+
+// RetrieveSpotOrdersCancelled mocks base method.
+func (m *MockIService) RetrieveSpotOrdersCancelled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCancelled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotOrdersCancelled", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SpotOrderCancelled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotOrdersCancelled indicates an expected call of RetrieveSpotOrdersCancelled.
+func (mr *MockIServiceMockRecorder) RetrieveSpotOrdersCancelled(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotOrdersCancelled", reflect.TypeOf((*MockIService)(nil).RetrieveSpotOrdersCancelled), fromBlock, toBLock)
+}
+
+// RetrieveSpotOrdersCancelledLimit mocks base method.
+func (m *MockIService) RetrieveSpotOrdersCancelledLimit(limit uint64) ([]*models.SpotOrderCancelled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotOrdersCancelledLimit", limit)
+	ret0, _ := ret[0].([]*models.SpotOrderCancelled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotOrdersCancelledLimit indicates an expected call of RetrieveSpotOrdersCancelledLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSpotOrdersCancelledLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotOrdersCancelledLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSpotOrdersCancelledLimit), limit)
+}
+
+// RetrieveSpotOrdersCommitted mocks base method.
+func (m *MockIService) RetrieveSpotOrdersCommitted(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCommitted, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotOrdersCommitted", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SpotOrderCommitted)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotOrdersCommitted indicates an expected call of RetrieveSpotOrdersCommitted.
+func (mr *MockIServiceMockRecorder) RetrieveSpotOrdersCommitted(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotOrdersCommitted", reflect.TypeOf((*MockIService)(nil).RetrieveSpotOrdersCommitted), fromBlock, toBLock)
+}
+
+// RetrieveSpotOrdersCommittedLimit mocks base method.
+func (m *MockIService) RetrieveSpotOrdersCommittedLimit(limit uint64) ([]*models.SpotOrderCommitted, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotOrdersCommittedLimit", limit)
+	ret0, _ := ret[0].([]*models.SpotOrderCommitted)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotOrdersCommittedLimit indicates an expected call of RetrieveSpotOrdersCommittedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSpotOrdersCommittedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotOrdersCommittedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSpotOrdersCommittedLimit), limit)
+}
+
+// RetrieveSpotOrdersSettled mocks base method.
+func (m *MockIService) RetrieveSpotOrdersSettled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderSettled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotOrdersSettled", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SpotOrderSettled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotOrdersSettled indicates an expected call of RetrieveSpotOrdersSettled.
+func (mr *MockIServiceMockRecorder) RetrieveSpotOrdersSettled(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotOrdersSettled", reflect.TypeOf((*MockIService)(nil).RetrieveSpotOrdersSettled), fromBlock, toBLock)
+}
+
+// RetrieveSpotOrdersSettledLimit mocks base method.
+func (m *MockIService) RetrieveSpotOrdersSettledLimit(limit uint64) ([]*models.SpotOrderSettled, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSpotOrdersSettledLimit", limit)
+	ret0, _ := ret[0].([]*models.SpotOrderSettled)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSpotOrdersSettledLimit indicates an expected call of RetrieveSpotOrdersSettledLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSpotOrdersSettledLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSpotOrdersSettledLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSpotOrdersSettledLimit), limit)
+}
+
+// RetrieveSynthsBought mocks base method.
+func (m *MockIService) RetrieveSynthsBought(fromBlock uint64, toBLock *uint64) ([]*models.SynthBought, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsBought", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SynthBought)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsBought indicates an expected call of RetrieveSynthsBought.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsBought(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsBought", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsBought), fromBlock, toBLock)
+}
+
+// RetrieveSynthsBoughtLimit mocks base method.
+func (m *MockIService) RetrieveSynthsBoughtLimit(limit uint64) ([]*models.SynthBought, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsBoughtLimit", limit)
+	ret0, _ := ret[0].([]*models.SynthBought)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsBoughtLimit indicates an expected call of RetrieveSynthsBoughtLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsBoughtLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsBoughtLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsBoughtLimit), limit)
+}
+
+// RetrieveSynthsRegistered mocks base method.
+func (m *MockIService) RetrieveSynthsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.SynthRegistered, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsRegistered", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SynthRegistered)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsRegistered indicates an expected call of RetrieveSynthsRegistered.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsRegistered(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsRegistered", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsRegistered), fromBlock, toBLock)
+}
+
+// RetrieveSynthsRegisteredLimit mocks base method.
+func (m *MockIService) RetrieveSynthsRegisteredLimit(limit uint64) ([]*models.SynthRegistered, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsRegisteredLimit", limit)
+	ret0, _ := ret[0].([]*models.SynthRegistered)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsRegisteredLimit indicates an expected call of RetrieveSynthsRegisteredLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsRegisteredLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsRegisteredLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsRegisteredLimit), limit)
+}
+
+// RetrieveSynthsSold mocks base method.
+func (m *MockIService) RetrieveSynthsSold(fromBlock uint64, toBLock *uint64) ([]*models.SynthSold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsSold", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SynthSold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsSold indicates an expected call of RetrieveSynthsSold.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsSold(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsSold", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsSold), fromBlock, toBLock)
+}
+
+// RetrieveSynthsSoldLimit mocks base method.
+func (m *MockIService) RetrieveSynthsSoldLimit(limit uint64) ([]*models.SynthSold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsSoldLimit", limit)
+	ret0, _ := ret[0].([]*models.SynthSold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsSoldLimit indicates an expected call of RetrieveSynthsSoldLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsSoldLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsSoldLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsSoldLimit), limit)
+}
+
+// RetrieveSynthsUnwrapped mocks base method.
+func (m *MockIService) RetrieveSynthsUnwrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthUnwrapped, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsUnwrapped", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SynthUnwrapped)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsUnwrapped indicates an expected call of RetrieveSynthsUnwrapped.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsUnwrapped(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsUnwrapped", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsUnwrapped), fromBlock, toBLock)
+}
+
+// RetrieveSynthsUnwrappedLimit mocks base method.
+func (m *MockIService) RetrieveSynthsUnwrappedLimit(limit uint64) ([]*models.SynthUnwrapped, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsUnwrappedLimit", limit)
+	ret0, _ := ret[0].([]*models.SynthUnwrapped)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsUnwrappedLimit indicates an expected call of RetrieveSynthsUnwrappedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsUnwrappedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsUnwrappedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsUnwrappedLimit), limit)
+}
+
+// RetrieveSynthsWrapped mocks base method.
+func (m *MockIService) RetrieveSynthsWrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthWrapped, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsWrapped", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.SynthWrapped)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsWrapped indicates an expected call of RetrieveSynthsWrapped.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsWrapped(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsWrapped", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsWrapped), fromBlock, toBLock)
+}
+
+// RetrieveSynthsWrappedLimit mocks base method.
+func (m *MockIService) RetrieveSynthsWrappedLimit(limit uint64) ([]*models.SynthWrapped, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveSynthsWrappedLimit", limit)
+	ret0, _ := ret[0].([]*models.SynthWrapped)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveSynthsWrappedLimit indicates an expected call of RetrieveSynthsWrappedLimit.
+func (mr *MockIServiceMockRecorder) RetrieveSynthsWrappedLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveSynthsWrappedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveSynthsWrappedLimit), limit)
+}
+
 // RetrieveTrades mocks base method.
 func (m *MockIService) RetrieveTrades(fromBlock uint64, toBLock *uint64) ([]*models.Trade, error) {
 	m.ctrl.T.Helper()
@@ -516,6 +2736,21 @@ func (mr *MockIServiceMockRecorder) RetrieveTradesLimit(limit interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveTradesLimit", reflect.TypeOf((*MockIService)(nil).RetrieveTradesLimit), limit)
 }
 
+// RetrieveUSDBurned mocks base method.
+func (m *MockIService) RetrieveUSDBurned(fromBlock uint64, toBLock *uint64) ([]*models.USDBurned, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveUSDBurned", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.USDBurned)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveUSDBurned indicates an expected call of RetrieveUSDBurned.
+func (mr *MockIServiceMockRecorder) RetrieveUSDBurned(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveUSDBurned", reflect.TypeOf((*MockIService)(nil).RetrieveUSDBurned), fromBlock, toBLock)
+}
+
 // RetrieveUSDBurnedLimit mocks base method.
 func (m *MockIService) RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, error) {
 	m.ctrl.T.Helper()
@@ -531,6 +2766,21 @@ func (mr *MockIServiceMockRecorder) RetrieveUSDBurnedLimit(limit interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveUSDBurnedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveUSDBurnedLimit), limit)
 }
 
+// RetrieveUSDMinted mocks base method.
+func (m *MockIService) RetrieveUSDMinted(fromBlock uint64, toBLock *uint64) ([]*models.USDMinted, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveUSDMinted", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.USDMinted)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveUSDMinted indicates an expected call of RetrieveUSDMinted.
+func (mr *MockIServiceMockRecorder) RetrieveUSDMinted(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveUSDMinted", reflect.TypeOf((*MockIService)(nil).RetrieveUSDMinted), fromBlock, toBLock)
+}
+
 // RetrieveUSDMintedLimit mocks base method.
 func (m *MockIService) RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, error) {
 	m.ctrl.T.Helper()
@@ -545,3 +2795,63 @@ func (mr *MockIServiceMockRecorder) RetrieveUSDMintedLimit(limit interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveUSDMintedLimit", reflect.TypeOf((*MockIService)(nil).RetrieveUSDMintedLimit), limit)
 }
+
+// RetrieveVaultLiquidations mocks base method.
+func (m *MockIService) RetrieveVaultLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.VaultLiquidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveVaultLiquidations", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.VaultLiquidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveVaultLiquidations indicates an expected call of RetrieveVaultLiquidations.
+func (mr *MockIServiceMockRecorder) RetrieveVaultLiquidations(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveVaultLiquidations", reflect.TypeOf((*MockIService)(nil).RetrieveVaultLiquidations), fromBlock, toBLock)
+}
+
+// RetrieveVaultLiquidationsLimit mocks base method.
+func (m *MockIService) RetrieveVaultLiquidationsLimit(limit uint64) ([]*models.VaultLiquidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveVaultLiquidationsLimit", limit)
+	ret0, _ := ret[0].([]*models.VaultLiquidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveVaultLiquidationsLimit indicates an expected call of RetrieveVaultLiquidationsLimit.
+func (mr *MockIServiceMockRecorder) RetrieveVaultLiquidationsLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveVaultLiquidationsLimit", reflect.TypeOf((*MockIService)(nil).RetrieveVaultLiquidationsLimit), limit)
+}
+
+// RetrieveWrappersSet mocks base method.
+func (m *MockIService) RetrieveWrappersSet(fromBlock uint64, toBLock *uint64) ([]*models.WrapperSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveWrappersSet", fromBlock, toBLock)
+	ret0, _ := ret[0].([]*models.WrapperSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveWrappersSet indicates an expected call of RetrieveWrappersSet.
+func (mr *MockIServiceMockRecorder) RetrieveWrappersSet(fromBlock, toBLock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveWrappersSet", reflect.TypeOf((*MockIService)(nil).RetrieveWrappersSet), fromBlock, toBLock)
+}
+
+// RetrieveWrappersSetLimit mocks base method.
+func (m *MockIService) RetrieveWrappersSetLimit(limit uint64) ([]*models.WrapperSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrieveWrappersSetLimit", limit)
+	ret0, _ := ret[0].([]*models.WrapperSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrieveWrappersSetLimit indicates an expected call of RetrieveWrappersSetLimit.
+func (mr *MockIServiceMockRecorder) RetrieveWrappersSetLimit(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrieveWrappersSetLimit", reflect.TypeOf((*MockIService)(nil).RetrieveWrappersSetLimit), limit)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
 
 // Account is a struct for account model
@@ -30,6 +32,20 @@ type AccountLiquidated struct {
 	FullLiquidated bool
 }
 
+// AccountCreated is a struct for perps market `AccountCreated` event
+//   - AccountID is an account NFT id
+//   - Owner is an address of the account owner
+//   - BlockNumber is a block number where the account was created
+//   - BlockTimestamp is a timestamp of the block where the account was created
+//   - TransactionHash is a hash of the transaction where the account was created
+type AccountCreated struct {
+	AccountID       *big.Int
+	Owner           common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
 // FormatAccount is used to get account from given data
 func FormatAccount(
 	id *big.Int,
@@ -39,8 +55,54 @@ func FormatAccount(
 ) *Account {
 	return &Account{
 		ID:              id,
-		Permissions:     getUserPermissions(permissions),
+		Permissions:     GetUserPermissions(permissions),
 		Owner:           owner,
 		LastInteraction: lastInteraction,
 	}
 }
+
+// GetAccountCreatedFromEvent is used to get AccountCreated struct from given event and block timestamp
+func GetAccountCreatedFromEvent(event *perpsMarket.PerpsMarketAccountCreated, time uint64) *AccountCreated {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-AccountCreated").Warning("nil event received")
+		return &AccountCreated{}
+	}
+
+	return &AccountCreated{
+		AccountID:       event.AccountId,
+		Owner:           event.Owner,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// CoreAccountCreated is a struct for core `AccountCreated` event
+//   - AccountID is a core account NFT id
+//   - Owner is an address of the account owner
+//   - BlockNumber is a block number where the account was created
+//   - BlockTimestamp is a timestamp of the block where the account was created
+//   - TransactionHash is a hash of the transaction where the account was created
+type CoreAccountCreated struct {
+	AccountID       *big.Int
+	Owner           common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetCoreAccountCreatedFromEvent is used to get CoreAccountCreated struct from given event and block timestamp
+func GetCoreAccountCreatedFromEvent(event *core.CoreAccountCreated, time uint64) *CoreAccountCreated {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-CoreAccountCreated").Warning("nil event received")
+		return &CoreAccountCreated{}
+	}
+
+	return &CoreAccountCreated{
+		AccountID:       event.AccountId,
+		Owner:           event.Owner,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
@@ -0,0 +1,11 @@
+package models
+
+import "math/big"
+
+// AccountCollateral is a perps market account collateral data struct
+//   - SynthMarketID: ID of the collateral synth market, 0 for sUSD.
+//   - Amount: Deposited amount of the collateral.
+type AccountCollateral struct {
+	SynthMarketID *big.Int
+	Amount        *big.Int
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -176,3 +178,109 @@ func TestFormatAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAccountCreatedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketAccountCreated
+		time  uint64
+		want  *AccountCreated
+	}{
+		{
+			name: "nil event",
+			want: &AccountCreated{},
+		},
+		{
+			name: "only account ID",
+			event: &perpsMarket.PerpsMarketAccountCreated{
+				AccountId: big.NewInt(1),
+			},
+			want: &AccountCreated{
+				AccountID:       big.NewInt(1),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketAccountCreated{
+				AccountId: big.NewInt(1),
+				Owner:     common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &AccountCreated{
+				AccountID:       big.NewInt(1),
+				Owner:           common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetAccountCreatedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetCoreAccountCreatedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CoreAccountCreated
+		time  uint64
+		want  *CoreAccountCreated
+	}{
+		{
+			name: "nil event",
+			want: &CoreAccountCreated{},
+		},
+		{
+			name: "only account ID",
+			event: &core.CoreAccountCreated{
+				AccountId: big.NewInt(1),
+			},
+			want: &CoreAccountCreated{
+				AccountID:       big.NewInt(1),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CoreAccountCreated{
+				AccountId: big.NewInt(1),
+				Owner:     common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &CoreAccountCreated{
+				AccountID:       big.NewInt(1),
+				Owner:           common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetCoreAccountCreatedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -67,3 +67,106 @@ func GetCollateralWithdrawnFromEvent(event *core.CoreWithdrawn, time uint64) *Co
 		BlockTimestamp: time,
 	}
 }
+
+// CollateralConfiguration is a Core smart-contract collateral configuration struct. BlockNumber, BlockTimestamp and
+// TransactionHash are filled only for data retrieved from the `CollateralConfigured` event
+type CollateralConfiguration struct {
+	CollateralType       common.Address
+	DepositingEnabled    bool
+	IssuanceRatioD18     *big.Int
+	LiquidationRatioD18  *big.Int
+	LiquidationRewardD18 *big.Int
+	OracleNodeId         [32]byte
+	TokenAddress         common.Address
+	MinDelegationD18     *big.Int
+	BlockNumber          uint64
+	BlockTimestamp       uint64
+	TransactionHash      string
+}
+
+// GetCollateralConfigurationFromContract is used to get CollateralConfiguration struct from given contract data struct
+func GetCollateralConfigurationFromContract(config core.CollateralConfigurationData) *CollateralConfiguration {
+	return &CollateralConfiguration{
+		CollateralType:       config.TokenAddress,
+		DepositingEnabled:    config.DepositingEnabled,
+		IssuanceRatioD18:     config.IssuanceRatioD18,
+		LiquidationRatioD18:  config.LiquidationRatioD18,
+		LiquidationRewardD18: config.LiquidationRewardD18,
+		OracleNodeId:         config.OracleNodeId,
+		TokenAddress:         config.TokenAddress,
+		MinDelegationD18:     config.MinDelegationD18,
+	}
+}
+
+// GetCollateralConfigurationFromEvent is used to get CollateralConfiguration struct from given `CollateralConfigured`
+// contract event
+func GetCollateralConfigurationFromEvent(event *core.CoreCollateralConfigured, time uint64) *CollateralConfiguration {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-CollateralConfiguration").Warning("nil event received")
+		return &CollateralConfiguration{}
+	}
+
+	config := GetCollateralConfigurationFromContract(event.Config)
+	config.CollateralType = event.CollateralType
+	config.BlockNumber = event.Raw.BlockNumber
+	config.BlockTimestamp = time
+	config.TransactionHash = event.Raw.TxHash.Hex()
+
+	return config
+}
+
+// CollateralLock is a unified `CollateralLockCreated` and `CollateralLockExpired` Core smart-contract events struct,
+// Created is true for a created lock and false for an expired one
+type CollateralLock struct {
+	AccountId       *big.Int
+	CollateralType  common.Address
+	TokenAmount     *big.Int
+	ExpireTimestamp uint64
+	Created         bool
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+	LogIndex        uint
+}
+
+// GetCollateralLockFromCreatedEvent is used to get CollateralLock struct from given `CollateralLockCreated` contract
+// event
+func GetCollateralLockFromCreatedEvent(event *core.CoreCollateralLockCreated, time uint64) *CollateralLock {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-CollateralLock").Warning("nil created event received")
+		return &CollateralLock{Created: true}
+	}
+
+	return &CollateralLock{
+		AccountId:       event.AccountId,
+		CollateralType:  event.CollateralType,
+		TokenAmount:     event.TokenAmount,
+		ExpireTimestamp: event.ExpireTimestamp,
+		Created:         true,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+		LogIndex:        event.Raw.Index,
+	}
+}
+
+// GetCollateralLockFromExpiredEvent is used to get CollateralLock struct from given `CollateralLockExpired` contract
+// event
+func GetCollateralLockFromExpiredEvent(event *core.CoreCollateralLockExpired, time uint64) *CollateralLock {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-CollateralLock").Warning("nil expired event received")
+		return &CollateralLock{}
+	}
+
+	return &CollateralLock{
+		AccountId:       event.AccountId,
+		CollateralType:  event.CollateralType,
+		TokenAmount:     event.TokenAmount,
+		ExpireTimestamp: event.ExpireTimestamp,
+		Created:         false,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+		LogIndex:        event.Raw.Index,
+	}
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// CollateralModified is a perps market `CollateralModified` event model
+//   - AccountID: ID of the account which margin was modified.
+//   - SynthMarketID: ID of the synth market used as collateral (0 for snxUSD).
+//   - AmountDelta: Signed amount of collateral deposited (positive) or withdrawn (negative).
+//   - Sender: Address of the sender of the transaction.
+//   - BlockNumber: Block number where the collateral was modified.
+//   - BlockTimestamp: Timestamp of the block where the collateral was modified.
+//   - TransactionHash: Hash of the transaction where the collateral was modified.
+type CollateralModified struct {
+	AccountID       *big.Int
+	SynthMarketID   uint64
+	AmountDelta     *big.Int
+	Sender          common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetCollateralModifiedFromEvent is used to get CollateralModified struct from given event and block timestamp
+func GetCollateralModifiedFromEvent(event *perpsMarket.PerpsMarketCollateralModified, time uint64) *CollateralModified {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-CollateralModified").Warning("nil event received")
+		return &CollateralModified{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &CollateralModified{
+		AccountID:       event.AccountId,
+		SynthMarketID:   synthMarketID,
+		AmountDelta:     event.AmountDelta,
+		Sender:          event.Sender,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// PerpsCollateralConfig is a perps market collateral configuration model. BlockNumber and BlockTimestamp are filled
+// only for data retrieved from the `CollateralConfigurationSet` event
+//   - SynthMarketID: ID of the synth market used as collateral.
+//   - MaxCollateralAmount: Max amount of the synth which can be deposited as margin.
+//   - Disabled: True if max collateral amount is zero and the synth cannot be deposited.
+//   - BlockNumber: Block number where the collateral was configured.
+//   - BlockTimestamp: Timestamp of the block where the collateral was configured.
+type PerpsCollateralConfig struct {
+	SynthMarketID       uint64
+	MaxCollateralAmount *big.Int
+	Disabled            bool
+	BlockNumber         uint64
+	BlockTimestamp      uint64
+}
+
+// GetPerpsCollateralConfig is used to get PerpsCollateralConfig struct from given synth market ID and
+// `getCollateralConfiguration` contract method output
+func GetPerpsCollateralConfig(synthMarketID *big.Int, maxCollateralAmount *big.Int) *PerpsCollateralConfig {
+	id := uint64(0)
+	if synthMarketID != nil {
+		id = synthMarketID.Uint64()
+	}
+
+	return &PerpsCollateralConfig{
+		SynthMarketID:       id,
+		MaxCollateralAmount: maxCollateralAmount,
+		Disabled:            maxCollateralAmount == nil || maxCollateralAmount.Sign() == 0,
+	}
+}
+
+// GetPerpsCollateralConfigFromEvent is used to get PerpsCollateralConfig struct from given event and block timestamp
+func GetPerpsCollateralConfigFromEvent(
+	event *perpsMarket.PerpsMarketCollateralConfigurationSet,
+	time uint64,
+) *PerpsCollateralConfig {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PerpsCollateralConfig").Warning("nil event received")
+		return &PerpsCollateralConfig{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &PerpsCollateralConfig{
+		SynthMarketID:       synthMarketID,
+		MaxCollateralAmount: event.MaxCollateralAmount,
+		Disabled:            event.MaxCollateralAmount == nil || event.MaxCollateralAmount.Sign() == 0,
+		BlockNumber:         event.Raw.BlockNumber,
+		BlockTimestamp:      time,
+	}
+}
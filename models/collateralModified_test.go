@@ -0,0 +1,176 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+)
+
+func TestGetCollateralModifiedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	amountDelta := new(big.Int)
+	amountDelta.SetString("-1500000000000000000000", 10)
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketCollateralModified
+		time  uint64
+		want  *CollateralModified
+	}{
+		{
+			name: "nil event",
+			want: &CollateralModified{},
+		},
+		{
+			name: "only synth market ID",
+			event: &perpsMarket.PerpsMarketCollateralModified{
+				SynthMarketId: big.NewInt(1),
+			},
+			want: &CollateralModified{
+				SynthMarketID:   uint64(1),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketCollateralModified{
+				AccountId:     big.NewInt(8714),
+				SynthMarketId: big.NewInt(0),
+				AmountDelta:   amountDelta,
+				Sender:        common.HexToAddress("0xC47fF8a340dFc0605be060886F0B6AEea0db653f"),
+				Raw: types.Log{
+					BlockNumber: 13920954,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &CollateralModified{
+				AccountID:       big.NewInt(8714),
+				SynthMarketID:   uint64(0),
+				AmountDelta:     amountDelta,
+				Sender:          common.HexToAddress("0xC47fF8a340dFc0605be060886F0B6AEea0db653f"),
+				BlockNumber:     13920954,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetCollateralModifiedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetPerpsCollateralConfigFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	maxCollateralAmount := new(big.Int)
+	maxCollateralAmount.SetString("100000000000000000000000", 10)
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketCollateralConfigurationSet
+		time  uint64
+		want  *PerpsCollateralConfig
+	}{
+		{
+			name: "nil event",
+			want: &PerpsCollateralConfig{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketCollateralConfigurationSet{
+				SynthMarketId:       big.NewInt(1),
+				MaxCollateralAmount: maxCollateralAmount,
+				Raw: types.Log{
+					BlockNumber: 2,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &PerpsCollateralConfig{
+				SynthMarketID:       1,
+				MaxCollateralAmount: maxCollateralAmount,
+				BlockNumber:         2,
+				BlockTimestamp:      uint64(timeNow.Unix()),
+			},
+		},
+		{
+			name: "disabled collateral",
+			event: &perpsMarket.PerpsMarketCollateralConfigurationSet{
+				SynthMarketId:       big.NewInt(1),
+				MaxCollateralAmount: big.NewInt(0),
+				Raw: types.Log{
+					BlockNumber: 2,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &PerpsCollateralConfig{
+				SynthMarketID:       1,
+				MaxCollateralAmount: big.NewInt(0),
+				Disabled:            true,
+				BlockNumber:         2,
+				BlockTimestamp:      uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPerpsCollateralConfigFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetPerpsCollateralConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		id        *big.Int
+		maxAmount *big.Int
+		want      *PerpsCollateralConfig
+	}{
+		{
+			name: "nil values",
+			want: &PerpsCollateralConfig{Disabled: true},
+		},
+		{
+			name:      "enabled collateral",
+			id:        big.NewInt(1),
+			maxAmount: big.NewInt(100),
+			want: &PerpsCollateralConfig{
+				SynthMarketID:       1,
+				MaxCollateralAmount: big.NewInt(100),
+			},
+		},
+		{
+			name:      "disabled collateral",
+			id:        big.NewInt(1),
+			maxAmount: big.NewInt(0),
+			want: &PerpsCollateralConfig{
+				SynthMarketID:       1,
+				MaxCollateralAmount: big.NewInt(0),
+				Disabled:            true,
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPerpsCollateralConfig(tt.id, tt.maxAmount)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
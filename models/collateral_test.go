@@ -0,0 +1,147 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetCollateralConfigurationFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CoreCollateralConfigured
+		time  uint64
+		want  *CollateralConfiguration
+	}{
+		{
+			name: "nil event",
+			want: &CollateralConfiguration{},
+		},
+		{
+			name: "only collateral type",
+			event: &core.CoreCollateralConfigured{
+				CollateralType: common.BytesToAddress([]byte("collateral")),
+			},
+			want: &CollateralConfiguration{
+				CollateralType:  common.BytesToAddress([]byte("collateral")),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CoreCollateralConfigured{
+				CollateralType: common.BytesToAddress([]byte("collateral")),
+				Config: core.CollateralConfigurationData{
+					DepositingEnabled:    true,
+					IssuanceRatioD18:     big.NewInt(3),
+					LiquidationRatioD18:  big.NewInt(2),
+					LiquidationRewardD18: big.NewInt(1),
+					OracleNodeId:         [32]byte{1, 2, 3},
+					TokenAddress:         common.BytesToAddress([]byte("collateral")),
+					MinDelegationD18:     big.NewInt(100),
+				},
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &CollateralConfiguration{
+				CollateralType:       common.BytesToAddress([]byte("collateral")),
+				DepositingEnabled:    true,
+				IssuanceRatioD18:     big.NewInt(3),
+				LiquidationRatioD18:  big.NewInt(2),
+				LiquidationRewardD18: big.NewInt(1),
+				OracleNodeId:         [32]byte{1, 2, 3},
+				TokenAddress:         common.BytesToAddress([]byte("collateral")),
+				MinDelegationD18:     big.NewInt(100),
+				BlockNumber:          2,
+				BlockTimestamp:       uint64(timeNow.Unix()),
+				TransactionHash:      common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetCollateralConfigurationFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetCollateralLockFromEvents(t *testing.T) {
+	timeNow := uint64(time.Now().Unix())
+	raw := types.Log{
+		BlockNumber: 2,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+		Index:       4,
+	}
+	want := func(created bool) *CollateralLock {
+		return &CollateralLock{
+			AccountId:       big.NewInt(1),
+			CollateralType:  common.BytesToAddress([]byte("collateral")),
+			TokenAmount:     big.NewInt(100),
+			ExpireTimestamp: 1700000000,
+			Created:         created,
+			BlockNumber:     2,
+			BlockTimestamp:  timeNow,
+			TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			LogIndex:        4,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		res  *CollateralLock
+		want *CollateralLock
+	}{
+		{
+			name: "nil created event",
+			res:  GetCollateralLockFromCreatedEvent(nil, timeNow),
+			want: &CollateralLock{Created: true},
+		},
+		{
+			name: "created event",
+			res: GetCollateralLockFromCreatedEvent(&core.CoreCollateralLockCreated{
+				AccountId:       big.NewInt(1),
+				CollateralType:  common.BytesToAddress([]byte("collateral")),
+				TokenAmount:     big.NewInt(100),
+				ExpireTimestamp: 1700000000,
+				Raw:             raw,
+			}, timeNow),
+			want: want(true),
+		},
+		{
+			name: "nil expired event",
+			res:  GetCollateralLockFromExpiredEvent(nil, timeNow),
+			want: &CollateralLock{},
+		},
+		{
+			name: "expired event",
+			res: GetCollateralLockFromExpiredEvent(&core.CoreCollateralLockExpired{
+				AccountId:       big.NewInt(1),
+				CollateralType:  common.BytesToAddress([]byte("collateral")),
+				TokenAmount:     big.NewInt(100),
+				ExpireTimestamp: 1700000000,
+				Raw:             raw,
+			}, timeNow),
+			want: want(false),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.res)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// CoreLiquidation is a `Liquidation` Core smart-contract event struct of a liquidated LP position, liquidation data is
+// flattened to DebtLiquidated, CollateralLiquidated and AmountRewarded fields. Not to be confused with perps market
+// position Liquidation
+type CoreLiquidation struct {
+	AccountId            *big.Int
+	PoolId               *big.Int
+	CollateralType       common.Address
+	DebtLiquidated       *big.Int
+	CollateralLiquidated *big.Int
+	AmountRewarded       *big.Int
+	LiquidateAsAccountId *big.Int
+	Sender               common.Address
+	BlockNumber          uint64
+	BlockTimestamp       uint64
+	TransactionHash      string
+}
+
+// GetCoreLiquidationFromEvent is used to get CoreLiquidation struct from given contract event
+func GetCoreLiquidationFromEvent(event *core.CoreLiquidation, time uint64) *CoreLiquidation {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-CoreLiquidation").Warning("nil event received")
+		return &CoreLiquidation{}
+	}
+
+	return &CoreLiquidation{
+		AccountId:            event.AccountId,
+		PoolId:               event.PoolId,
+		CollateralType:       event.CollateralType,
+		DebtLiquidated:       event.LiquidationData.DebtLiquidated,
+		CollateralLiquidated: event.LiquidationData.CollateralLiquidated,
+		AmountRewarded:       event.LiquidationData.AmountRewarded,
+		LiquidateAsAccountId: event.LiquidateAsAccountId,
+		Sender:               event.Sender,
+		BlockNumber:          event.Raw.BlockNumber,
+		BlockTimestamp:       time,
+		TransactionHash:      event.Raw.TxHash.Hex(),
+	}
+}
+
+// VaultLiquidation is a `VaultLiquidation` Core smart-contract event struct of a fully liquidated vault, liquidation
+// data is flattened the same way as in CoreLiquidation
+type VaultLiquidation struct {
+	PoolId               *big.Int
+	CollateralType       common.Address
+	DebtLiquidated       *big.Int
+	CollateralLiquidated *big.Int
+	AmountRewarded       *big.Int
+	LiquidateAsAccountId *big.Int
+	Sender               common.Address
+	BlockNumber          uint64
+	BlockTimestamp       uint64
+	TransactionHash      string
+}
+
+// GetVaultLiquidationFromEvent is used to get VaultLiquidation struct from given contract event
+func GetVaultLiquidationFromEvent(event *core.CoreVaultLiquidation, time uint64) *VaultLiquidation {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-VaultLiquidation").Warning("nil event received")
+		return &VaultLiquidation{}
+	}
+
+	return &VaultLiquidation{
+		PoolId:               event.PoolId,
+		CollateralType:       event.CollateralType,
+		DebtLiquidated:       event.LiquidationData.DebtLiquidated,
+		CollateralLiquidated: event.LiquidationData.CollateralLiquidated,
+		AmountRewarded:       event.LiquidationData.AmountRewarded,
+		LiquidateAsAccountId: event.LiquidateAsAccountId,
+		Sender:               event.Sender,
+		BlockNumber:          event.Raw.BlockNumber,
+		BlockTimestamp:       time,
+		TransactionHash:      event.Raw.TxHash.Hex(),
+	}
+}
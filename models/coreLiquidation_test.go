@@ -0,0 +1,145 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetCoreLiquidationFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CoreLiquidation
+		time  uint64
+		want  *CoreLiquidation
+	}{
+		{
+			name: "nil event",
+			want: &CoreLiquidation{},
+		},
+		{
+			name: "only account ID",
+			event: &core.CoreLiquidation{
+				AccountId: big.NewInt(1),
+			},
+			want: &CoreLiquidation{
+				AccountId:       big.NewInt(1),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CoreLiquidation{
+				AccountId:      big.NewInt(1),
+				PoolId:         big.NewInt(2),
+				CollateralType: common.BytesToAddress([]byte("collateral")),
+				LiquidationData: core.ILiquidationModuleLiquidationData{
+					DebtLiquidated:       big.NewInt(100),
+					CollateralLiquidated: big.NewInt(200),
+					AmountRewarded:       big.NewInt(10),
+				},
+				LiquidateAsAccountId: big.NewInt(3),
+				Sender:               common.BytesToAddress([]byte("sender")),
+				Raw: types.Log{
+					BlockNumber: 4,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &CoreLiquidation{
+				AccountId:            big.NewInt(1),
+				PoolId:               big.NewInt(2),
+				CollateralType:       common.BytesToAddress([]byte("collateral")),
+				DebtLiquidated:       big.NewInt(100),
+				CollateralLiquidated: big.NewInt(200),
+				AmountRewarded:       big.NewInt(10),
+				LiquidateAsAccountId: big.NewInt(3),
+				Sender:               common.BytesToAddress([]byte("sender")),
+				BlockNumber:          4,
+				BlockTimestamp:       uint64(timeNow.Unix()),
+				TransactionHash:      common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetCoreLiquidationFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetVaultLiquidationFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CoreVaultLiquidation
+		time  uint64
+		want  *VaultLiquidation
+	}{
+		{
+			name: "nil event",
+			want: &VaultLiquidation{},
+		},
+		{
+			name: "only pool ID",
+			event: &core.CoreVaultLiquidation{
+				PoolId: big.NewInt(2),
+			},
+			want: &VaultLiquidation{
+				PoolId:          big.NewInt(2),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CoreVaultLiquidation{
+				PoolId:         big.NewInt(2),
+				CollateralType: common.BytesToAddress([]byte("collateral")),
+				LiquidationData: core.ILiquidationModuleLiquidationData{
+					DebtLiquidated:       big.NewInt(100),
+					CollateralLiquidated: big.NewInt(200),
+					AmountRewarded:       big.NewInt(10),
+				},
+				LiquidateAsAccountId: big.NewInt(3),
+				Sender:               common.BytesToAddress([]byte("sender")),
+				Raw: types.Log{
+					BlockNumber: 4,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &VaultLiquidation{
+				PoolId:               big.NewInt(2),
+				CollateralType:       common.BytesToAddress([]byte("collateral")),
+				DebtLiquidated:       big.NewInt(100),
+				CollateralLiquidated: big.NewInt(200),
+				AmountRewarded:       big.NewInt(10),
+				LiquidateAsAccountId: big.NewInt(3),
+				Sender:               common.BytesToAddress([]byte("sender")),
+				BlockNumber:          4,
+				BlockTimestamp:       uint64(timeNow.Unix()),
+				TransactionHash:      common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetVaultLiquidationFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+// FlowDirection is a direction enum of the funds flow between a market and the core system
+type FlowDirection int
+
+const (
+	DEPOSIT FlowDirection = iota
+	WITHDRAWAL
+)
+
+// flowDirectionsS is mapping FlowDirection to its string value
+var flowDirectionsS = [...]string{
+	DEPOSIT:    "DEPOSIT",
+	WITHDRAWAL: "WITHDRAWAL",
+}
+
+// String is used to return FlowDirection string value
+func (d FlowDirection) String() string {
+	return flowDirectionsS[d]
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// KeeperRewardGuards is a perps market keeper reward guards model. BlockNumber and BlockTimestamp are filled only for
+// data retrieved from the `KeeperRewardGuardsSet` event
+//   - MinKeeperRewardUsd: Minimum keeper reward in USD.
+//   - MinKeeperProfitRatioD18: Minimum keeper profit ratio.
+//   - MaxKeeperRewardUsd: Maximum keeper reward in USD.
+//   - MaxKeeperScalingRatioD18: Maximum keeper scaling ratio.
+//   - BlockNumber: Block number where the guards were set.
+//   - BlockTimestamp: Timestamp of the block where the guards were set.
+type KeeperRewardGuards struct {
+	MinKeeperRewardUsd       *big.Int
+	MinKeeperProfitRatioD18  *big.Int
+	MaxKeeperRewardUsd       *big.Int
+	MaxKeeperScalingRatioD18 *big.Int
+	BlockNumber              uint64
+	BlockTimestamp           uint64
+}
+
+// GetKeeperRewardGuards is used to get KeeperRewardGuards struct from given `getKeeperRewardGuards` contract method
+// outputs
+func GetKeeperRewardGuards(resp struct {
+	MinKeeperRewardUsd       *big.Int
+	MinKeeperProfitRatioD18  *big.Int
+	MaxKeeperRewardUsd       *big.Int
+	MaxKeeperScalingRatioD18 *big.Int
+}) *KeeperRewardGuards {
+	return &KeeperRewardGuards{
+		MinKeeperRewardUsd:       resp.MinKeeperRewardUsd,
+		MinKeeperProfitRatioD18:  resp.MinKeeperProfitRatioD18,
+		MaxKeeperRewardUsd:       resp.MaxKeeperRewardUsd,
+		MaxKeeperScalingRatioD18: resp.MaxKeeperScalingRatioD18,
+	}
+}
+
+// GetKeeperRewardGuardsFromEvent is used to get KeeperRewardGuards struct from given event and block timestamp
+func GetKeeperRewardGuardsFromEvent(event *perpsMarket.PerpsMarketKeeperRewardGuardsSet, time uint64) *KeeperRewardGuards {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-KeeperRewardGuards").Warning("nil event received")
+		return &KeeperRewardGuards{}
+	}
+
+	return &KeeperRewardGuards{
+		MinKeeperRewardUsd:       event.MinKeeperRewardUsd,
+		MinKeeperProfitRatioD18:  event.MinKeeperProfitRatioD18,
+		MaxKeeperRewardUsd:       event.MaxKeeperRewardUsd,
+		MaxKeeperScalingRatioD18: event.MaxKeeperScalingRatioD18,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+	}
+}
+
+// PerAccountCaps is a perps market per account caps model. BlockNumber and BlockTimestamp are filled only for data
+// retrieved from the `PerAccountCapsSet` event
+//   - MaxPositionsPerAccount: Max number of open positions per account.
+//   - MaxCollateralsPerAccount: Max number of collaterals per account.
+//   - BlockNumber: Block number where the caps were set.
+//   - BlockTimestamp: Timestamp of the block where the caps were set.
+type PerAccountCaps struct {
+	MaxPositionsPerAccount   *big.Int
+	MaxCollateralsPerAccount *big.Int
+	BlockNumber              uint64
+	BlockTimestamp           uint64
+}
+
+// GetPerAccountCapsFromEvent is used to get PerAccountCaps struct from given event and block timestamp
+func GetPerAccountCapsFromEvent(event *perpsMarket.PerpsMarketPerAccountCapsSet, time uint64) *PerAccountCaps {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PerAccountCaps").Warning("nil event received")
+		return &PerAccountCaps{}
+	}
+
+	return &PerAccountCaps{
+		MaxPositionsPerAccount:   event.MaxPositionsPerAccount,
+		MaxCollateralsPerAccount: event.MaxCollateralsPerAccount,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+	}
+}
+
+// FeeCollector is a perps market `FeeCollectorSet` event model
+//   - FeeCollector: Address of the new fee collector contract.
+//   - BlockNumber: Block number where the fee collector was set.
+//   - BlockTimestamp: Timestamp of the block where the fee collector was set.
+type FeeCollector struct {
+	FeeCollector   common.Address
+	BlockNumber    uint64
+	BlockTimestamp uint64
+}
+
+// ReferrerShare is a perps market `ReferrerShareUpdated` event model
+//   - Referrer: Address of the referrer.
+//   - ShareRatioD18: Share of the fees the referrer earns.
+//   - BlockNumber: Block number where the share was updated.
+//   - BlockTimestamp: Timestamp of the block where the share was updated.
+type ReferrerShare struct {
+	Referrer       common.Address
+	ShareRatioD18  *big.Int
+	BlockNumber    uint64
+	BlockTimestamp uint64
+}
+
+// GetFeeCollectorFromEvent is used to get FeeCollector struct from given event and block timestamp
+func GetFeeCollectorFromEvent(event *perpsMarket.PerpsMarketFeeCollectorSet, time uint64) *FeeCollector {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-FeeCollector").Warning("nil event received")
+		return &FeeCollector{}
+	}
+
+	return &FeeCollector{
+		FeeCollector:   event.FeeCollector,
+		BlockNumber:    event.Raw.BlockNumber,
+		BlockTimestamp: time,
+	}
+}
+
+// GetReferrerShareFromEvent is used to get ReferrerShare struct from given event and block timestamp
+func GetReferrerShareFromEvent(event *perpsMarket.PerpsMarketReferrerShareUpdated, time uint64) *ReferrerShare {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-ReferrerShare").Warning("nil event received")
+		return &ReferrerShare{}
+	}
+
+	return &ReferrerShare{
+		Referrer:       event.Referrer,
+		ShareRatioD18:  event.ShareRatioD18,
+		BlockNumber:    event.Raw.BlockNumber,
+		BlockTimestamp: time,
+	}
+}
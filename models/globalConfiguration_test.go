@@ -0,0 +1,179 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+)
+
+func TestGetKeeperRewardGuardsFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketKeeperRewardGuardsSet
+		time  uint64
+		want  *KeeperRewardGuards
+	}{
+		{
+			name: "nil event",
+			want: &KeeperRewardGuards{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketKeeperRewardGuardsSet{
+				MinKeeperRewardUsd:       big.NewInt(1),
+				MinKeeperProfitRatioD18:  big.NewInt(2),
+				MaxKeeperRewardUsd:       big.NewInt(3),
+				MaxKeeperScalingRatioD18: big.NewInt(4),
+				Raw: types.Log{
+					BlockNumber: 5,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &KeeperRewardGuards{
+				MinKeeperRewardUsd:       big.NewInt(1),
+				MinKeeperProfitRatioD18:  big.NewInt(2),
+				MaxKeeperRewardUsd:       big.NewInt(3),
+				MaxKeeperScalingRatioD18: big.NewInt(4),
+				BlockNumber:              5,
+				BlockTimestamp:           uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetKeeperRewardGuardsFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetPerAccountCapsFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketPerAccountCapsSet
+		time  uint64
+		want  *PerAccountCaps
+	}{
+		{
+			name: "nil event",
+			want: &PerAccountCaps{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketPerAccountCapsSet{
+				MaxPositionsPerAccount:   big.NewInt(5),
+				MaxCollateralsPerAccount: big.NewInt(3),
+				Raw: types.Log{
+					BlockNumber: 1,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &PerAccountCaps{
+				MaxPositionsPerAccount:   big.NewInt(5),
+				MaxCollateralsPerAccount: big.NewInt(3),
+				BlockNumber:              1,
+				BlockTimestamp:           uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPerAccountCapsFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetFeeCollectorFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketFeeCollectorSet
+		time  uint64
+		want  *FeeCollector
+	}{
+		{
+			name: "nil event",
+			want: &FeeCollector{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketFeeCollectorSet{
+				FeeCollector: common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Raw: types.Log{
+					BlockNumber: 1,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &FeeCollector{
+				FeeCollector:   common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				BlockNumber:    1,
+				BlockTimestamp: uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetFeeCollectorFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetReferrerShareFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketReferrerShareUpdated
+		time  uint64
+		want  *ReferrerShare
+	}{
+		{
+			name: "nil event",
+			want: &ReferrerShare{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketReferrerShareUpdated{
+				Referrer:      common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				ShareRatioD18: big.NewInt(100000000000000000),
+				Raw: types.Log{
+					BlockNumber: 1,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &ReferrerShare{
+				Referrer:       common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				ShareRatioD18:  big.NewInt(100000000000000000),
+				BlockNumber:    1,
+				BlockTimestamp: uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetReferrerShareFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
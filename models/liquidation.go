@@ -44,3 +44,49 @@ func GetLiquidationFromEvent(event *perpsMarket.PerpsMarketPositionLiquidated, t
 		BlockTimestamp:      time,
 	}
 }
+
+// LiquidationCheck is an account liquidation check model
+//   - AccountID: ID of the checked account.
+//   - CanLiquidate: Define is account can be liquidated or not. Always false if check failed.
+//   - Err: Error of the account check, nil if check succeeded.
+type LiquidationCheck struct {
+	AccountID    *big.Int
+	CanLiquidate bool
+	Err          error
+}
+
+// AccountLiquidationAttempt is an account liquidation attempt model
+//   - AccountID: ID of the liquidated account.
+//   - Reward: Liquidation reward transferred to the caller.
+//   - FullLiquidation: Define is account fully liquidated within this attempt or not.
+//   - BlockNumber: Block number where the liquidation was attempted.
+//   - BlockTimestamp: Timestamp of the block where the liquidation was attempted.
+//   - TransactionHash: Hash of the transaction where the liquidation was attempted.
+type AccountLiquidationAttempt struct {
+	AccountID       *big.Int
+	Reward          *big.Int
+	FullLiquidation bool
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetAccountLiquidationAttemptFromEvent is used to get AccountLiquidationAttempt struct from given contract event
+func GetAccountLiquidationAttemptFromEvent(
+	event *perpsMarket.PerpsMarketAccountLiquidationAttempt,
+	time uint64,
+) *AccountLiquidationAttempt {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-AccountLiquidationAttempt").Warning("nil event received")
+		return &AccountLiquidationAttempt{}
+	}
+
+	return &AccountLiquidationAttempt{
+		AccountID:       event.AccountId,
+		Reward:          event.Reward,
+		FullLiquidation: event.FullLiquidation,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/stretchr/testify/require"
@@ -71,3 +72,66 @@ func TestGetLiquidationFromEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAccountLiquidationAttemptFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketAccountLiquidationAttempt
+		time  uint64
+		want  *AccountLiquidationAttempt
+	}{
+		{
+			name: "nil event",
+			want: &AccountLiquidationAttempt{},
+		},
+		{
+			name: "partial liquidation",
+			event: &perpsMarket.PerpsMarketAccountLiquidationAttempt{
+				AccountId: big.NewInt(1),
+				Reward:    big.NewInt(2),
+				Raw: types.Log{
+					BlockNumber: 3,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &AccountLiquidationAttempt{
+				AccountID:       big.NewInt(1),
+				Reward:          big.NewInt(2),
+				BlockNumber:     3,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full liquidation",
+			event: &perpsMarket.PerpsMarketAccountLiquidationAttempt{
+				AccountId:       big.NewInt(1),
+				Reward:          big.NewInt(2),
+				FullLiquidation: true,
+				Raw: types.Log{
+					BlockNumber: 3,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &AccountLiquidationAttempt{
+				AccountID:       big.NewInt(1),
+				Reward:          big.NewInt(2),
+				FullLiquidation: true,
+				BlockNumber:     3,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetAccountLiquidationAttemptFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// MarketCollateralChange is a unified `MarketCollateralDeposited` and `MarketCollateralWithdrawn` Core smart-contract
+// events struct, Direction is used to distinguish deposits from withdrawals
+type MarketCollateralChange struct {
+	MarketId                 *big.Int
+	CollateralType           common.Address
+	TokenAmount              *big.Int
+	Sender                   common.Address
+	Direction                FlowDirection
+	CreditCapacity           *big.Int
+	NetIssuance              *big.Int
+	DepositedCollateralValue *big.Int
+	ReportedDebt             *big.Int
+	BlockNumber              uint64
+	BlockTimestamp           uint64
+	TransactionHash          string
+	LogIndex                 uint
+}
+
+// GetMarketCollateralChangeFromDepositedEvent is used to get MarketCollateralChange struct from given
+// `MarketCollateralDeposited` contract event
+func GetMarketCollateralChangeFromDepositedEvent(event *core.CoreMarketCollateralDeposited, time uint64) *MarketCollateralChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketCollateralChange").Warning("nil deposited event received")
+		return &MarketCollateralChange{Direction: DEPOSIT}
+	}
+
+	return &MarketCollateralChange{
+		MarketId:                 event.MarketId,
+		CollateralType:           event.CollateralType,
+		TokenAmount:              event.TokenAmount,
+		Sender:                   event.Sender,
+		Direction:                DEPOSIT,
+		CreditCapacity:           event.CreditCapacity,
+		NetIssuance:              event.NetIssuance,
+		DepositedCollateralValue: event.DepositedCollateralValue,
+		ReportedDebt:             event.ReportedDebt,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+		TransactionHash:          event.Raw.TxHash.Hex(),
+		LogIndex:                 event.Raw.Index,
+	}
+}
+
+// GetMarketCollateralChangeFromWithdrawnEvent is used to get MarketCollateralChange struct from given
+// `MarketCollateralWithdrawn` contract event
+func GetMarketCollateralChangeFromWithdrawnEvent(event *core.CoreMarketCollateralWithdrawn, time uint64) *MarketCollateralChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketCollateralChange").Warning("nil withdrawn event received")
+		return &MarketCollateralChange{Direction: WITHDRAWAL}
+	}
+
+	return &MarketCollateralChange{
+		MarketId:                 event.MarketId,
+		CollateralType:           event.CollateralType,
+		TokenAmount:              event.TokenAmount,
+		Sender:                   event.Sender,
+		Direction:                WITHDRAWAL,
+		CreditCapacity:           event.CreditCapacity,
+		NetIssuance:              event.NetIssuance,
+		DepositedCollateralValue: event.DepositedCollateralValue,
+		ReportedDebt:             event.ReportedDebt,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+		TransactionHash:          event.Raw.TxHash.Hex(),
+		LogIndex:                 event.Raw.Index,
+	}
+}
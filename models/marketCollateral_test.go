@@ -0,0 +1,97 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetMarketCollateralChangeFromEvents(t *testing.T) {
+	timeNow := uint64(time.Now().Unix())
+	raw := types.Log{
+		BlockNumber: 2,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+		Index:       5,
+	}
+	want := func(direction FlowDirection) *MarketCollateralChange {
+		return &MarketCollateralChange{
+			MarketId:                 big.NewInt(1),
+			CollateralType:           common.BytesToAddress([]byte("collateral")),
+			TokenAmount:              big.NewInt(100),
+			Sender:                   common.BytesToAddress([]byte("sender")),
+			Direction:                direction,
+			CreditCapacity:           big.NewInt(10),
+			NetIssuance:              big.NewInt(-20),
+			DepositedCollateralValue: big.NewInt(30),
+			ReportedDebt:             big.NewInt(40),
+			BlockNumber:              2,
+			BlockTimestamp:           timeNow,
+			TransactionHash:          common.BytesToHash([]byte("tx hash")).Hex(),
+			LogIndex:                 5,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		res  *MarketCollateralChange
+		want *MarketCollateralChange
+	}{
+		{
+			name: "nil deposited event",
+			res:  GetMarketCollateralChangeFromDepositedEvent(nil, timeNow),
+			want: &MarketCollateralChange{Direction: DEPOSIT},
+		},
+		{
+			name: "deposited event",
+			res: GetMarketCollateralChangeFromDepositedEvent(&core.CoreMarketCollateralDeposited{
+				MarketId:                 big.NewInt(1),
+				CollateralType:           common.BytesToAddress([]byte("collateral")),
+				TokenAmount:              big.NewInt(100),
+				Sender:                   common.BytesToAddress([]byte("sender")),
+				CreditCapacity:           big.NewInt(10),
+				NetIssuance:              big.NewInt(-20),
+				DepositedCollateralValue: big.NewInt(30),
+				ReportedDebt:             big.NewInt(40),
+				Raw:                      raw,
+			}, timeNow),
+			want: want(DEPOSIT),
+		},
+		{
+			name: "nil withdrawn event",
+			res:  GetMarketCollateralChangeFromWithdrawnEvent(nil, timeNow),
+			want: &MarketCollateralChange{Direction: WITHDRAWAL},
+		},
+		{
+			name: "withdrawn event",
+			res: GetMarketCollateralChangeFromWithdrawnEvent(&core.CoreMarketCollateralWithdrawn{
+				MarketId:                 big.NewInt(1),
+				CollateralType:           common.BytesToAddress([]byte("collateral")),
+				TokenAmount:              big.NewInt(100),
+				Sender:                   common.BytesToAddress([]byte("sender")),
+				CreditCapacity:           big.NewInt(10),
+				NetIssuance:              big.NewInt(-20),
+				DepositedCollateralValue: big.NewInt(30),
+				ReportedDebt:             big.NewInt(40),
+				Raw:                      raw,
+			}, timeNow),
+			want: want(WITHDRAWAL),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.res)
+		})
+	}
+}
+
+func TestFlowDirection_String(t *testing.T) {
+	require.Equal(t, "DEPOSIT", DEPOSIT.String())
+	require.Equal(t, "WITHDRAWAL", WITHDRAWAL.String())
+}
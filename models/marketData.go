@@ -3,6 +3,8 @@ package models
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
@@ -66,6 +68,22 @@ type MarketMetadata struct {
 	Symbol   string
 }
 
+// MarketCreated is a perps market `MarketCreated` event model
+//   - PerpsMarketID: ID of the created market.
+//   - MarketName: Name of the created market.
+//   - MarketSymbol: Symbol of the created market for example 'ETH'.
+//   - BlockNumber: Block number where the market was created.
+//   - BlockTimestamp: Timestamp of the block where the market was created.
+//   - TransactionHash: Hash of the transaction where the market was created.
+type MarketCreated struct {
+	PerpsMarketID   uint64
+	MarketName      string
+	MarketSymbol    string
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
 // MarketSummary is a market summary data struct
 //   - MarketID - Represents the ID of the market
 //   - Skew - Represents the skew of the market
@@ -86,19 +104,43 @@ type MarketSummary struct {
 	BlockTimestamp         uint64
 }
 
+// LiquidationParameters is a market liquidation parameters model. MarketID, BlockNumber and BlockTimestamp are filled
+// only for data retrieved from the `LiquidationParametersSet` event
+//   - MarketID: ID of the market.
+//   - InitialMarginRatio: Contract initialMarginRatioD18 value.
+//   - MinimumInitialMarginRatio: Contract minimumInitialMarginRatioD18 value.
+//   - MaintenanceMarginScalar: Contract maintenanceMarginScalarD18 value (maintenanceMarginRatioD18 in the event).
+//   - LiquidationRewardRatio: Contract flagRewardRatioD18 value.
+//   - MinimumPositionMargin: Contract minimumPositionMargin value.
+//   - BlockNumber: Block number where the parameters were set.
+//   - BlockTimestamp: Timestamp of the block where the parameters were set.
 type LiquidationParameters struct {
+	MarketID                  uint64
 	InitialMarginRatio        *big.Int
 	MinimumInitialMarginRatio *big.Int
 	MaintenanceMarginScalar   *big.Int
 	LiquidationRewardRatio    *big.Int
 	MinimumPositionMargin     *big.Int
+	BlockNumber               uint64
+	BlockTimestamp            uint64
 }
 
+// FundingParameters is a market funding parameters model. MarketID, BlockNumber and BlockTimestamp are filled only for
+// data retrieved from the `FundingParametersSet` event
+//   - MarketID: ID of the market.
+//   - SkewScale: Skew scale of the market.
+//   - MaxFundingVelocity: Maximum funding velocity of the market.
+//   - BlockNumber: Block number where the parameters were set.
+//   - BlockTimestamp: Timestamp of the block where the parameters were set.
 type FundingParameters struct {
+	MarketID           uint64
 	SkewScale          *big.Int
 	MaxFundingVelocity *big.Int
+	BlockNumber        uint64
+	BlockTimestamp     uint64
 }
 
+// GetFundingParameters is used to get FundingParameters struct from given `getFundingParameters` contract method outputs
 func GetFundingParameters(resp struct {
 	SkewScale          *big.Int
 	MaxFundingVelocity *big.Int
@@ -109,8 +151,29 @@ func GetFundingParameters(resp struct {
 	}
 }
 
-// LiquidationRewardRatioD18 changed to FlagRewardRatioD18
+// GetFundingParametersFromEvent is used to get FundingParameters struct from given event and block timestamp
+func GetFundingParametersFromEvent(event *perpsMarket.PerpsMarketFundingParametersSet, time uint64) *FundingParameters {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-FundingParameters").Warning("nil event received")
+		return &FundingParameters{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &FundingParameters{
+		MarketID:           marketID,
+		SkewScale:          event.SkewScale,
+		MaxFundingVelocity: event.MaxFundingVelocity,
+		BlockNumber:        event.Raw.BlockNumber,
+		BlockTimestamp:     time,
+	}
+}
 
+// GetLiquidationParameters is used to get LiquidationParameters struct from given `getLiquidationParameters` contract
+// method outputs. Contract flagRewardRatioD18 value is stored as LiquidationRewardRatio
 func GetLiquidationParameters(resp struct {
 	InitialMarginRatioD18        *big.Int
 	MinimumInitialMarginRatioD18 *big.Int
@@ -127,6 +190,217 @@ func GetLiquidationParameters(resp struct {
 	}
 }
 
+// GetLiquidationParametersFromEvent is used to get LiquidationParameters struct from given event and block timestamp
+func GetLiquidationParametersFromEvent(
+	event *perpsMarket.PerpsMarketLiquidationParametersSet,
+	time uint64,
+) *LiquidationParameters {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-LiquidationParameters").Warning("nil event received")
+		return &LiquidationParameters{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &LiquidationParameters{
+		MarketID:                  marketID,
+		InitialMarginRatio:        event.InitialMarginRatioD18,
+		MinimumInitialMarginRatio: event.MinimumInitialMarginRatioD18,
+		MaintenanceMarginScalar:   event.MaintenanceMarginRatioD18,
+		LiquidationRewardRatio:    event.FlagRewardRatioD18,
+		MinimumPositionMargin:     event.MinimumPositionMargin,
+		BlockNumber:               event.Raw.BlockNumber,
+		BlockTimestamp:            time,
+	}
+}
+
+// MaxLiquidationParameters is a market max liquidation parameters model. MarketID, BlockNumber and BlockTimestamp are
+// filled only for data retrieved from the `MaxLiquidationParametersSet` event
+//   - MarketID: ID of the market.
+//   - MaxLiquidationLimitAccumulationMultiplier: Multiplier used to calculate max liquidation amount per window.
+//   - MaxSecondsInLiquidationWindow: Duration of the liquidation window in seconds.
+//   - MaxLiquidationPd: Max premium/discount allowed to liquidate more than max liquidation amount.
+//   - EndorsedLiquidator: Address of the liquidator allowed to liquidate without limits.
+//   - BlockNumber: Block number where the parameters were set.
+//   - BlockTimestamp: Timestamp of the block where the parameters were set.
+type MaxLiquidationParameters struct {
+	MarketID                                  uint64
+	MaxLiquidationLimitAccumulationMultiplier *big.Int
+	MaxSecondsInLiquidationWindow             *big.Int
+	MaxLiquidationPd                          *big.Int
+	EndorsedLiquidator                        common.Address
+	BlockNumber                               uint64
+	BlockTimestamp                            uint64
+}
+
+// GetMaxLiquidationParametersFromEvent is used to get MaxLiquidationParameters struct from given event and block
+// timestamp
+func GetMaxLiquidationParametersFromEvent(
+	event *perpsMarket.PerpsMarketMaxLiquidationParametersSet,
+	time uint64,
+) *MaxLiquidationParameters {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MaxLiquidationParameters").Warning("nil event received")
+		return &MaxLiquidationParameters{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &MaxLiquidationParameters{
+		MarketID: marketID,
+		MaxLiquidationLimitAccumulationMultiplier: event.MaxLiquidationLimitAccumulationMultiplier,
+		MaxSecondsInLiquidationWindow:             event.MaxSecondsInLiquidationWindow,
+		MaxLiquidationPd:                          event.MaxLiquidationPd,
+		EndorsedLiquidator:                        event.EndorsedLiquidator,
+		BlockNumber:                               event.Raw.BlockNumber,
+		BlockTimestamp:                            time,
+	}
+}
+
+// MaxMarketSize is a market max size model. BlockNumber and BlockTimestamp are filled only for data retrieved from the
+// `MaxMarketSizeSet` event
+//   - MarketID: ID of the market.
+//   - MaxMarketSize: Max open interest of the market.
+//   - BlockNumber: Block number where the max market size was set.
+//   - BlockTimestamp: Timestamp of the block where the max market size was set.
+type MaxMarketSize struct {
+	MarketID       uint64
+	MaxMarketSize  *big.Int
+	BlockNumber    uint64
+	BlockTimestamp uint64
+}
+
+// GetMaxMarketSizeFromEvent is used to get MaxMarketSize struct from given event and block timestamp
+func GetMaxMarketSizeFromEvent(event *perpsMarket.PerpsMarketMaxMarketSizeSet, time uint64) *MaxMarketSize {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MaxMarketSize").Warning("nil event received")
+		return &MaxMarketSize{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &MaxMarketSize{
+		MarketID:       marketID,
+		MaxMarketSize:  event.MaxMarketSize,
+		BlockNumber:    event.Raw.BlockNumber,
+		BlockTimestamp: time,
+	}
+}
+
+// OrderFees is a market order fees model. MarketID, BlockNumber and BlockTimestamp are filled only for data retrieved
+// from the `OrderFeesSet` event
+//   - MarketID: ID of the market.
+//   - MakerFeeRatio: Maker fee ratio of the market.
+//   - TakerFeeRatio: Taker fee ratio of the market.
+//   - BlockNumber: Block number where the fees were set.
+//   - BlockTimestamp: Timestamp of the block where the fees were set.
+type OrderFees struct {
+	MarketID       uint64
+	MakerFeeRatio  *big.Int
+	TakerFeeRatio  *big.Int
+	BlockNumber    uint64
+	BlockTimestamp uint64
+}
+
+// GetOrderFees is used to get OrderFees struct from given `getOrderFees` contract method outputs
+func GetOrderFees(resp struct {
+	MakerFee *big.Int
+	TakerFee *big.Int
+}) *OrderFees {
+	return &OrderFees{
+		MakerFeeRatio: resp.MakerFee,
+		TakerFeeRatio: resp.TakerFee,
+	}
+}
+
+// OrderFeeQuote is a perps market order fees quote model
+//   - OrderFees: Fees the contract will charge for the order.
+//   - FillPrice: Price the order will be filled at.
+type OrderFeeQuote struct {
+	OrderFees *big.Int
+	FillPrice *big.Int
+}
+
+// GetOrderFeeQuote is used to get OrderFeeQuote struct from given `computeOrderFees` contract method outputs
+func GetOrderFeeQuote(resp struct {
+	OrderFees *big.Int
+	FillPrice *big.Int
+}) *OrderFeeQuote {
+	return &OrderFeeQuote{
+		OrderFees: resp.OrderFees,
+		FillPrice: resp.FillPrice,
+	}
+}
+
+// GetOrderFeesFromEvent is used to get OrderFees struct from given event and block timestamp
+func GetOrderFeesFromEvent(event *perpsMarket.PerpsMarketOrderFeesSet, time uint64) *OrderFees {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-OrderFees").Warning("nil event received")
+		return &OrderFees{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &OrderFees{
+		MarketID:       marketID,
+		MakerFeeRatio:  event.MakerFeeRatio,
+		TakerFeeRatio:  event.TakerFeeRatio,
+		BlockNumber:    event.Raw.BlockNumber,
+		BlockTimestamp: time,
+	}
+}
+
+// MarketPriceData is a market price data model. BlockNumber, BlockTimestamp and TransactionHash are filled only for
+// data retrieved from the `MarketPriceDataUpdated` event
+//   - MarketID: ID of the market.
+//   - FeedID: Price feed ID of the market as a hex string, the same format as PriceFeedID string values.
+//   - StrictStalenessTolerance: Strict staleness tolerance of the price in seconds.
+//   - BlockNumber: Block number where the price data was updated.
+//   - BlockTimestamp: Timestamp of the block where the price data was updated.
+//   - TransactionHash: Hash of the transaction where the price data was updated.
+type MarketPriceData struct {
+	MarketID                 uint64
+	FeedID                   string
+	StrictStalenessTolerance *big.Int
+	BlockNumber              uint64
+	BlockTimestamp           uint64
+	TransactionHash          string
+}
+
+// GetMarketPriceDataFromEvent is used to get MarketPriceData struct from given event and block timestamp
+func GetMarketPriceDataFromEvent(event *perpsMarket.PerpsMarketMarketPriceDataUpdated, time uint64) *MarketPriceData {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketPriceData").Warning("nil event received")
+		return &MarketPriceData{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &MarketPriceData{
+		MarketID:                 marketID,
+		FeedID:                   common.BytesToHash(event.FeedId[:]).Hex(),
+		StrictStalenessTolerance: event.StrictStalenessTolerance,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+		TransactionHash:          event.Raw.TxHash.Hex(),
+	}
+}
+
 // GetMarketUpdateFromEvent is used to get MarketUpdate struct from given event and block timestamp
 func GetMarketUpdateFromEvent(event *perpsMarket.PerpsMarketMarketUpdated, time uint64) *MarketUpdate {
 	if event == nil {
@@ -226,3 +500,25 @@ func GetMarketSummaryFromContractModel(summary perpsMarket.IPerpsMarketModuleMar
 		BlockTimestamp:         time,
 	}
 }
+
+// GetMarketCreatedFromEvent is used to get MarketCreated struct from given event and block timestamp
+func GetMarketCreatedFromEvent(event *perpsMarket.PerpsMarketMarketCreated, time uint64) *MarketCreated {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketCreated").Warning("nil event received")
+		return &MarketCreated{}
+	}
+
+	marketID := uint64(0)
+	if event.PerpsMarketId != nil {
+		marketID = event.PerpsMarketId.Uint64()
+	}
+
+	return &MarketCreated{
+		PerpsMarketID:   marketID,
+		MarketName:      event.MarketName,
+		MarketSymbol:    event.MarketSymbol,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
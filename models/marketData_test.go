@@ -282,3 +282,382 @@ func TestGetMarketSummaryFromContractModel(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFundingParametersFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketFundingParametersSet
+		time  uint64
+		want  *FundingParameters
+	}{
+		{
+			name: "nil event",
+			want: &FundingParameters{},
+		},
+		{
+			name: "only market ID",
+			event: &perpsMarket.PerpsMarketFundingParametersSet{
+				MarketId: big.NewInt(100),
+			},
+			want: &FundingParameters{
+				MarketID: 100,
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketFundingParametersSet{
+				MarketId:           big.NewInt(100),
+				SkewScale:          big.NewInt(1),
+				MaxFundingVelocity: big.NewInt(2),
+				Raw: types.Log{
+					BlockNumber: 3,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &FundingParameters{
+				MarketID:           100,
+				SkewScale:          big.NewInt(1),
+				MaxFundingVelocity: big.NewInt(2),
+				BlockNumber:        3,
+				BlockTimestamp:     uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetFundingParametersFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetMarketCreatedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketMarketCreated
+		time  uint64
+		want  *MarketCreated
+	}{
+		{
+			name: "nil event",
+			want: &MarketCreated{},
+		},
+		{
+			name: "only market ID",
+			event: &perpsMarket.PerpsMarketMarketCreated{
+				PerpsMarketId: big.NewInt(100),
+			},
+			want: &MarketCreated{
+				PerpsMarketID:   100,
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketMarketCreated{
+				PerpsMarketId: big.NewInt(100),
+				MarketName:    "Ethereum",
+				MarketSymbol:  "ETH",
+				Raw: types.Log{
+					BlockNumber: 1,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &MarketCreated{
+				PerpsMarketID:   100,
+				MarketName:      "Ethereum",
+				MarketSymbol:    "ETH",
+				BlockNumber:     1,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetMarketCreatedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetLiquidationParametersFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketLiquidationParametersSet
+		time  uint64
+		want  *LiquidationParameters
+	}{
+		{
+			name: "nil event",
+			want: &LiquidationParameters{},
+		},
+		{
+			name: "only market ID",
+			event: &perpsMarket.PerpsMarketLiquidationParametersSet{
+				MarketId: big.NewInt(100),
+			},
+			want: &LiquidationParameters{
+				MarketID: 100,
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketLiquidationParametersSet{
+				MarketId:                     big.NewInt(100),
+				InitialMarginRatioD18:        big.NewInt(1),
+				MaintenanceMarginRatioD18:    big.NewInt(2),
+				MinimumInitialMarginRatioD18: big.NewInt(3),
+				FlagRewardRatioD18:           big.NewInt(4),
+				MinimumPositionMargin:        big.NewInt(5),
+				Raw: types.Log{
+					BlockNumber: 6,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &LiquidationParameters{
+				MarketID:                  100,
+				InitialMarginRatio:        big.NewInt(1),
+				MaintenanceMarginScalar:   big.NewInt(2),
+				MinimumInitialMarginRatio: big.NewInt(3),
+				LiquidationRewardRatio:    big.NewInt(4),
+				MinimumPositionMargin:     big.NewInt(5),
+				BlockNumber:               6,
+				BlockTimestamp:            uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetLiquidationParametersFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetMaxLiquidationParametersFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketMaxLiquidationParametersSet
+		time  uint64
+		want  *MaxLiquidationParameters
+	}{
+		{
+			name: "nil event",
+			want: &MaxLiquidationParameters{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketMaxLiquidationParametersSet{
+				MarketId: big.NewInt(100),
+				MaxLiquidationLimitAccumulationMultiplier: big.NewInt(1),
+				MaxSecondsInLiquidationWindow:             big.NewInt(30),
+				MaxLiquidationPd:                          big.NewInt(2),
+				EndorsedLiquidator:                        common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Raw: types.Log{
+					BlockNumber: 3,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &MaxLiquidationParameters{
+				MarketID: 100,
+				MaxLiquidationLimitAccumulationMultiplier: big.NewInt(1),
+				MaxSecondsInLiquidationWindow:             big.NewInt(30),
+				MaxLiquidationPd:                          big.NewInt(2),
+				EndorsedLiquidator:                        common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				BlockNumber:                               3,
+				BlockTimestamp:                            uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetMaxLiquidationParametersFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetMaxMarketSizeFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	maxMarketSize := new(big.Int)
+	maxMarketSize.SetString("10000000000000000000000", 10)
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketMaxMarketSizeSet
+		time  uint64
+		want  *MaxMarketSize
+	}{
+		{
+			name: "nil event",
+			want: &MaxMarketSize{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketMaxMarketSizeSet{
+				MarketId:      big.NewInt(100),
+				MaxMarketSize: maxMarketSize,
+				Raw: types.Log{
+					BlockNumber: 1,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &MaxMarketSize{
+				MarketID:       100,
+				MaxMarketSize:  maxMarketSize,
+				BlockNumber:    1,
+				BlockTimestamp: uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetMaxMarketSizeFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetOrderFeesFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketOrderFeesSet
+		time  uint64
+		want  *OrderFees
+	}{
+		{
+			name: "nil event",
+			want: &OrderFees{},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketOrderFeesSet{
+				MarketId:      big.NewInt(100),
+				MakerFeeRatio: big.NewInt(200000000000000),
+				TakerFeeRatio: big.NewInt(500000000000000),
+				Raw: types.Log{
+					BlockNumber: 1,
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &OrderFees{
+				MarketID:       100,
+				MakerFeeRatio:  big.NewInt(200000000000000),
+				TakerFeeRatio:  big.NewInt(500000000000000),
+				BlockNumber:    1,
+				BlockTimestamp: uint64(timeNow.Unix()),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetOrderFeesFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetMarketPriceDataFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketMarketPriceDataUpdated
+		time  uint64
+		want  *MarketPriceData
+	}{
+		{
+			name: "nil event",
+			want: &MarketPriceData{},
+		},
+		{
+			name: "only market ID",
+			event: &perpsMarket.PerpsMarketMarketPriceDataUpdated{
+				MarketId: big.NewInt(100),
+			},
+			want: &MarketPriceData{
+				MarketID:        100,
+				FeedID:          "0x0000000000000000000000000000000000000000000000000000000000000000",
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketMarketPriceDataUpdated{
+				MarketId:                 big.NewInt(100),
+				FeedId:                   common.HexToHash(ETH.String()),
+				StrictStalenessTolerance: big.NewInt(60),
+				Raw: types.Log{
+					BlockNumber: 1,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &MarketPriceData{
+				MarketID:                 100,
+				FeedID:                   ETH.String(),
+				StrictStalenessTolerance: big.NewInt(60),
+				BlockNumber:              1,
+				BlockTimestamp:           uint64(timeNow.Unix()),
+				TransactionHash:          common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetMarketPriceDataFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetLiquidationParameters(t *testing.T) {
+	res := GetLiquidationParameters(struct {
+		InitialMarginRatioD18        *big.Int
+		MinimumInitialMarginRatioD18 *big.Int
+		MaintenanceMarginScalarD18   *big.Int
+		FlagRewardRatioD18           *big.Int
+		MinimumPositionMargin        *big.Int
+	}{
+		InitialMarginRatioD18:        big.NewInt(1),
+		MinimumInitialMarginRatioD18: big.NewInt(2),
+		MaintenanceMarginScalarD18:   big.NewInt(3),
+		FlagRewardRatioD18:           big.NewInt(4),
+		MinimumPositionMargin:        big.NewInt(5),
+	})
+
+	require.Equal(t, &LiquidationParameters{
+		InitialMarginRatio:        big.NewInt(1),
+		MinimumInitialMarginRatio: big.NewInt(2),
+		MaintenanceMarginScalar:   big.NewInt(3),
+		LiquidationRewardRatio:    big.NewInt(4),
+		MinimumPositionMargin:     big.NewInt(5),
+	}, res)
+}
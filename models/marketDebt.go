@@ -0,0 +1,29 @@
+package models
+
+import "math/big"
+
+// MarketDebtSummary is a struct with core debt values of the market
+//   - MarketID: market ID
+//   - ReportedDebt: debt reported by the market itself, 18 decimals
+//   - TotalDebt: total market debt including deposited collateral and issued snxUSD, 18 decimals. Negative when the
+//     market is in credit
+//   - WithdrawableUsd: amount of snxUSD the market can currently withdraw from the core, 18 decimals
+type MarketDebtSummary struct {
+	MarketID        *big.Int
+	ReportedDebt    *big.Int
+	TotalDebt       *big.Int
+	WithdrawableUsd *big.Int
+}
+
+// MarketBackingSummary is a struct with core values required to compute backing ratio of the market
+//   - MarketID: market ID
+//   - NetIssuance: snxUSD issued by the market minus deposited snxUSD, 18 decimals. Negative when the market deposited
+//     more than it withdrew
+//   - CollateralValue: USD value of collateral deposited by the market, 18 decimals
+//   - ReportedDebt: debt reported by the market itself, 18 decimals
+type MarketBackingSummary struct {
+	MarketID        *big.Int
+	NetIssuance     *big.Int
+	CollateralValue *big.Int
+	ReportedDebt    *big.Int
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// MarketRegistered is a `MarketRegistered` Core smart-contract event struct
+type MarketRegistered struct {
+	Market          common.Address
+	MarketId        *big.Int
+	Sender          common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetMarketRegisteredFromEvent is used to get MarketRegistered struct from given contract event
+func GetMarketRegisteredFromEvent(event *core.CoreMarketRegistered, time uint64) *MarketRegistered {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketRegistered").Warning("nil event received")
+		return &MarketRegistered{}
+	}
+
+	return &MarketRegistered{
+		Market:          event.Market,
+		MarketId:        event.MarketId,
+		Sender:          event.Sender,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetMarketRegisteredFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CoreMarketRegistered
+		time  uint64
+		want  *MarketRegistered
+	}{
+		{
+			name: "nil event",
+			want: &MarketRegistered{},
+		},
+		{
+			name: "only market",
+			event: &core.CoreMarketRegistered{
+				Market: common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+			},
+			want: &MarketRegistered{
+				Market:          common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CoreMarketRegistered{
+				Market:   common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+				MarketId: big.NewInt(2),
+				Sender:   common.BytesToAddress([]byte("sender")),
+				Raw: types.Log{
+					BlockNumber: 3,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &MarketRegistered{
+				Market:          common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+				MarketId:        big.NewInt(2),
+				Sender:          common.BytesToAddress([]byte("sender")),
+				BlockNumber:     3,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetMarketRegisteredFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
 
 type MarketUSDDeposited struct {
@@ -70,3 +71,71 @@ func GetMarketUSDWithdrawnFromEvent(event *core.CoreMarketUsdWithdrawn, time uin
 
 	return m
 }
+
+// MarketUSDChange is a unified `MarketUsdDeposited` and `MarketUsdWithdrawn` Core smart-contract events struct,
+// Direction is used to distinguish deposits from withdrawals
+type MarketUSDChange struct {
+	MarketId                 *big.Int
+	Target                   common.Address
+	Amount                   *big.Int
+	Market                   common.Address
+	Direction                FlowDirection
+	CreditCapacity           *big.Int
+	NetIssuance              *big.Int
+	DepositedCollateralValue *big.Int
+	ReportedDebt             *big.Int
+	BlockNumber              uint64
+	BlockTimestamp           uint64
+	TransactionHash          string
+	LogIndex                 uint
+}
+
+// GetMarketUSDChangeFromDepositedEvent is used to get MarketUSDChange struct from given `MarketUsdDeposited` contract
+// event
+func GetMarketUSDChangeFromDepositedEvent(event *core.CoreMarketUsdDeposited, time uint64) *MarketUSDChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketUSDChange").Warning("nil deposited event received")
+		return &MarketUSDChange{Direction: DEPOSIT}
+	}
+
+	return &MarketUSDChange{
+		MarketId:                 event.MarketId,
+		Target:                   event.Target,
+		Amount:                   event.Amount,
+		Market:                   event.Market,
+		Direction:                DEPOSIT,
+		CreditCapacity:           event.CreditCapacity,
+		NetIssuance:              event.NetIssuance,
+		DepositedCollateralValue: event.DepositedCollateralValue,
+		ReportedDebt:             event.ReportedDebt,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+		TransactionHash:          event.Raw.TxHash.Hex(),
+		LogIndex:                 event.Raw.Index,
+	}
+}
+
+// GetMarketUSDChangeFromWithdrawnEvent is used to get MarketUSDChange struct from given `MarketUsdWithdrawn` contract
+// event
+func GetMarketUSDChangeFromWithdrawnEvent(event *core.CoreMarketUsdWithdrawn, time uint64) *MarketUSDChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-MarketUSDChange").Warning("nil withdrawn event received")
+		return &MarketUSDChange{Direction: WITHDRAWAL}
+	}
+
+	return &MarketUSDChange{
+		MarketId:                 event.MarketId,
+		Target:                   event.Target,
+		Amount:                   event.Amount,
+		Market:                   event.Market,
+		Direction:                WITHDRAWAL,
+		CreditCapacity:           event.CreditCapacity,
+		NetIssuance:              event.NetIssuance,
+		DepositedCollateralValue: event.DepositedCollateralValue,
+		ReportedDebt:             event.ReportedDebt,
+		BlockNumber:              event.Raw.BlockNumber,
+		BlockTimestamp:           time,
+		TransactionHash:          event.Raw.TxHash.Hex(),
+		LogIndex:                 event.Raw.Index,
+	}
+}
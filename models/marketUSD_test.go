@@ -0,0 +1,92 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetMarketUSDChangeFromEvents(t *testing.T) {
+	timeNow := uint64(time.Now().Unix())
+	raw := types.Log{
+		BlockNumber: 2,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+		Index:       5,
+	}
+	want := func(direction FlowDirection) *MarketUSDChange {
+		return &MarketUSDChange{
+			MarketId:                 big.NewInt(1),
+			Target:                   common.BytesToAddress([]byte("target")),
+			Amount:                   big.NewInt(100),
+			Market:                   common.BytesToAddress([]byte("market")),
+			Direction:                direction,
+			CreditCapacity:           big.NewInt(10),
+			NetIssuance:              big.NewInt(-20),
+			DepositedCollateralValue: big.NewInt(30),
+			ReportedDebt:             big.NewInt(40),
+			BlockNumber:              2,
+			BlockTimestamp:           timeNow,
+			TransactionHash:          common.BytesToHash([]byte("tx hash")).Hex(),
+			LogIndex:                 5,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		res  *MarketUSDChange
+		want *MarketUSDChange
+	}{
+		{
+			name: "nil deposited event",
+			res:  GetMarketUSDChangeFromDepositedEvent(nil, timeNow),
+			want: &MarketUSDChange{Direction: DEPOSIT},
+		},
+		{
+			name: "deposited event",
+			res: GetMarketUSDChangeFromDepositedEvent(&core.CoreMarketUsdDeposited{
+				MarketId:                 big.NewInt(1),
+				Target:                   common.BytesToAddress([]byte("target")),
+				Amount:                   big.NewInt(100),
+				Market:                   common.BytesToAddress([]byte("market")),
+				CreditCapacity:           big.NewInt(10),
+				NetIssuance:              big.NewInt(-20),
+				DepositedCollateralValue: big.NewInt(30),
+				ReportedDebt:             big.NewInt(40),
+				Raw:                      raw,
+			}, timeNow),
+			want: want(DEPOSIT),
+		},
+		{
+			name: "nil withdrawn event",
+			res:  GetMarketUSDChangeFromWithdrawnEvent(nil, timeNow),
+			want: &MarketUSDChange{Direction: WITHDRAWAL},
+		},
+		{
+			name: "withdrawn event",
+			res: GetMarketUSDChangeFromWithdrawnEvent(&core.CoreMarketUsdWithdrawn{
+				MarketId:                 big.NewInt(1),
+				Target:                   common.BytesToAddress([]byte("target")),
+				Amount:                   big.NewInt(100),
+				Market:                   common.BytesToAddress([]byte("market")),
+				CreditCapacity:           big.NewInt(10),
+				NetIssuance:              big.NewInt(-20),
+				DepositedCollateralValue: big.NewInt(30),
+				ReportedDebt:             big.NewInt(40),
+				Raw:                      raw,
+			}, timeNow),
+			want: want(WITHDRAWAL),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.res)
+		})
+	}
+}
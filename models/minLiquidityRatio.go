@@ -0,0 +1,13 @@
+package models
+
+import "math/big"
+
+// MinLiquidityRatio is a struct with min liquidity ratio applied to the market by the core
+//   - MarketID: market ID
+//   - RatioD18: min liquidity ratio, 18 decimals
+//   - SystemDefault: true if the market has no override set and RatioD18 is the system wide value
+type MinLiquidityRatio struct {
+	MarketID      *big.Int
+	RatioD18      *big.Int
+	SystemDefault bool
+}
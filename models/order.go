@@ -71,3 +71,162 @@ func GetOrderFromEvent(event *perpsMarket.PerpsMarketOrderCommitted, time uint64
 		BlockTimestamp:  time,
 	}
 }
+
+// OrderCanceled is an order canceled event model
+//   - MarketID: ID of the market used for the order.
+//   - AccountID: ID of the account used for the order.
+//   - DesiredPrice: Price of the order the trader was willing to accept.
+//   - FillPrice: Price at which the order would have been settled.
+//   - SizeDelta: Requested change in size of the order.
+//   - SettlementReward: Amount of fees collected by the settler.
+//   - TrackingCode: Optional code for integrator tracking purposes.
+//   - Settler: Address of the settler who canceled the order.
+//   - BlockNumber: Block number where the order was canceled.
+//   - BlockTimestamp: Timestamp of the block where the order was canceled.
+//   - TransactionHash: Hash of the transaction where the order was canceled.
+type OrderCanceled struct {
+	MarketID         uint64
+	AccountID        *big.Int
+	DesiredPrice     *big.Int
+	FillPrice        *big.Int
+	SizeDelta        *big.Int
+	SettlementReward *big.Int
+	TrackingCode     [32]byte
+	Settler          common.Address
+	BlockNumber      uint64
+	BlockTimestamp   uint64
+	TransactionHash  string
+}
+
+// GetOrderCanceledFromEvent is used to get OrderCanceled struct from given event and block timestamp
+func GetOrderCanceledFromEvent(event *perpsMarket.PerpsMarketOrderCancelled, time uint64) *OrderCanceled {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-OrderCanceled").Warning("nil event received")
+		return &OrderCanceled{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	return &OrderCanceled{
+		MarketID:         marketID,
+		AccountID:        event.AccountId,
+		DesiredPrice:     event.DesiredPrice,
+		FillPrice:        event.FillPrice,
+		SizeDelta:        event.SizeDelta,
+		SettlementReward: event.SettlementReward,
+		TrackingCode:     event.TrackingCode,
+		Settler:          event.Settler,
+		BlockNumber:      event.Raw.BlockNumber,
+		BlockTimestamp:   time,
+		TransactionHash:  event.Raw.TxHash.Hex(),
+	}
+}
+
+// OrderExpired is a previous order expired event model
+//   - MarketID: ID of the market used for the order.
+//   - AccountID: ID of the account used for the order.
+//   - SizeDelta: Requested change in size of the order.
+//   - AcceptablePrice: Maximum or minimum accepted price to settle the order.
+//   - CommitmentTime: Time at which the expired order was committed.
+//   - TrackingCode: Optional code for integrator tracking purposes.
+//   - BlockNumber: Block number where the order was marked as expired.
+//   - BlockTimestamp: Timestamp of the block where the order was marked as expired.
+//   - TransactionHash: Hash of the transaction where the order was marked as expired.
+type OrderExpired struct {
+	MarketID        uint64
+	AccountID       *big.Int
+	SizeDelta       *big.Int
+	AcceptablePrice *big.Int
+	CommitmentTime  uint64
+	TrackingCode    [32]byte
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetOrderExpiredFromEvent is used to get OrderExpired struct from given event and block timestamp
+func GetOrderExpiredFromEvent(event *perpsMarket.PerpsMarketPreviousOrderExpired, time uint64) *OrderExpired {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-OrderExpired").Warning("nil event received")
+		return &OrderExpired{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	commitmentTime := uint64(0)
+	if event.CommitmentTime != nil {
+		commitmentTime = event.CommitmentTime.Uint64()
+	}
+
+	return &OrderExpired{
+		MarketID:        marketID,
+		AccountID:       event.AccountId,
+		SizeDelta:       event.SizeDelta,
+		AcceptablePrice: event.AcceptablePrice,
+		CommitmentTime:  commitmentTime,
+		TrackingCode:    event.TrackingCode,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// PendingOrder is a perps market account pending async order model
+//   - MarketID: ID of the market used for the order.
+//   - AccountID: ID of the account used for the order.
+//   - CommitmentTime: Time at which the order was committed.
+//   - SizeDelta: Requested change in size of the order.
+//   - SettlementStrategyID: ID of the settlement strategy used for the order.
+//   - AcceptablePrice: Maximum or minimum accepted price to settle the order.
+//   - TrackingCode: Optional code for integrator tracking purposes.
+//   - Referrer: Address of the referrer of the order.
+type PendingOrder struct {
+	MarketID             uint64
+	AccountID            *big.Int
+	CommitmentTime       uint64
+	SizeDelta            *big.Int
+	SettlementStrategyID uint64
+	AcceptablePrice      *big.Int
+	TrackingCode         [32]byte
+	Referrer             common.Address
+}
+
+// GetPendingOrderFromContract is used to get PendingOrder struct from given `getOrder` contract method output. Nil is
+// returned if there is no pending order, which the contract reports as an order with zero size delta
+func GetPendingOrderFromContract(order perpsMarket.AsyncOrderData) *PendingOrder {
+	if order.Request.SizeDelta == nil || order.Request.SizeDelta.Sign() == 0 {
+		return nil
+	}
+
+	marketID := uint64(0)
+	if order.Request.MarketId != nil {
+		marketID = order.Request.MarketId.Uint64()
+	}
+
+	commitmentTime := uint64(0)
+	if order.CommitmentTime != nil {
+		commitmentTime = order.CommitmentTime.Uint64()
+	}
+
+	strategyID := uint64(0)
+	if order.Request.SettlementStrategyId != nil {
+		strategyID = order.Request.SettlementStrategyId.Uint64()
+	}
+
+	return &PendingOrder{
+		MarketID:             marketID,
+		AccountID:            order.Request.AccountId,
+		CommitmentTime:       commitmentTime,
+		SizeDelta:            order.Request.SizeDelta,
+		SettlementStrategyID: strategyID,
+		AcceptablePrice:      order.Request.AcceptablePrice,
+		TrackingCode:         order.Request.TrackingCode,
+		Referrer:             order.Request.Referrer,
+	}
+}
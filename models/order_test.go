@@ -102,3 +102,199 @@ func TestGetOrderFromEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOrderCanceledFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketOrderCancelled
+		time  uint64
+		want  *OrderCanceled
+	}{
+		{
+			name: "nil event",
+			want: &OrderCanceled{},
+		},
+		{
+			name: "only market ID",
+			event: &perpsMarket.PerpsMarketOrderCancelled{
+				MarketId: big.NewInt(1),
+			},
+			want: &OrderCanceled{
+				MarketID:        uint64(1),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketOrderCancelled{
+				MarketId:         big.NewInt(1),
+				AccountId:        big.NewInt(2),
+				DesiredPrice:     big.NewInt(3),
+				FillPrice:        big.NewInt(4),
+				SizeDelta:        big.NewInt(-5),
+				SettlementReward: big.NewInt(6),
+				TrackingCode:     crypto.Keccak256Hash([]byte("tracking_code")),
+				Settler:          common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+				Raw: types.Log{
+					BlockNumber: 7,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &OrderCanceled{
+				MarketID:         uint64(1),
+				AccountID:        big.NewInt(2),
+				DesiredPrice:     big.NewInt(3),
+				FillPrice:        big.NewInt(4),
+				SizeDelta:        big.NewInt(-5),
+				SettlementReward: big.NewInt(6),
+				TrackingCode:     crypto.Keccak256Hash([]byte("tracking_code")),
+				Settler:          common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+				BlockNumber:      7,
+				BlockTimestamp:   uint64(timeNow.Unix()),
+				TransactionHash:  common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetOrderCanceledFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetOrderExpiredFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketPreviousOrderExpired
+		time  uint64
+		want  *OrderExpired
+	}{
+		{
+			name: "nil event",
+			want: &OrderExpired{},
+		},
+		{
+			name: "only market ID",
+			event: &perpsMarket.PerpsMarketPreviousOrderExpired{
+				MarketId: big.NewInt(1),
+			},
+			want: &OrderExpired{
+				MarketID:        uint64(1),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "only commitment time",
+			event: &perpsMarket.PerpsMarketPreviousOrderExpired{
+				CommitmentTime: big.NewInt(int64(timeNow.Unix())),
+			},
+			want: &OrderExpired{
+				CommitmentTime:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketPreviousOrderExpired{
+				MarketId:        big.NewInt(1),
+				AccountId:       big.NewInt(2),
+				SizeDelta:       big.NewInt(-3),
+				AcceptablePrice: big.NewInt(4),
+				CommitmentTime:  big.NewInt(int64(timeNow.Unix())),
+				TrackingCode:    crypto.Keccak256Hash([]byte("tracking_code")),
+				Raw: types.Log{
+					BlockNumber: 5,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &OrderExpired{
+				MarketID:        uint64(1),
+				AccountID:       big.NewInt(2),
+				SizeDelta:       big.NewInt(-3),
+				AcceptablePrice: big.NewInt(4),
+				CommitmentTime:  uint64(timeNow.Unix()),
+				TrackingCode:    crypto.Keccak256Hash([]byte("tracking_code")),
+				BlockNumber:     5,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetOrderExpiredFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetPendingOrderFromContract(t *testing.T) {
+	testCases := []struct {
+		name  string
+		order perpsMarket.AsyncOrderData
+		want  *PendingOrder
+	}{
+		{
+			name: "no pending order",
+			want: nil,
+		},
+		{
+			name: "zero filled order",
+			order: perpsMarket.AsyncOrderData{
+				CommitmentTime: big.NewInt(0),
+				Request: perpsMarket.AsyncOrderOrderCommitmentRequest{
+					MarketId:             big.NewInt(0),
+					AccountId:            big.NewInt(0),
+					SizeDelta:            big.NewInt(0),
+					SettlementStrategyId: big.NewInt(0),
+					AcceptablePrice:      big.NewInt(0),
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "pending order",
+			order: perpsMarket.AsyncOrderData{
+				CommitmentTime: big.NewInt(1700000000),
+				Request: perpsMarket.AsyncOrderOrderCommitmentRequest{
+					MarketId:             big.NewInt(100),
+					AccountId:            big.NewInt(1),
+					SizeDelta:            big.NewInt(-5),
+					SettlementStrategyId: big.NewInt(1),
+					AcceptablePrice:      big.NewInt(2000),
+					TrackingCode:         [32]byte{1},
+					Referrer:             common.BytesToAddress([]byte("referrer")),
+				},
+			},
+			want: &PendingOrder{
+				MarketID:             100,
+				AccountID:            big.NewInt(1),
+				CommitmentTime:       1700000000,
+				SizeDelta:            big.NewInt(-5),
+				SettlementStrategyID: 1,
+				AcceptablePrice:      big.NewInt(2000),
+				TrackingCode:         [32]byte{1},
+				Referrer:             common.BytesToAddress([]byte("referrer")),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPendingOrderFromContract(tt.order)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
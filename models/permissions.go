@@ -33,11 +33,20 @@ var permissionsS = [...]string{
 	PERPS_COMMIT_ASYNC_ORDER: "PERPS_COMMIT_ASYNC_ORDER",
 }
 
-// String is used to return Permission string value
+// String is used to return Permission string value. Unsupported permissions return an empty string
 func (p Permission) String() string {
+	if !p.IsSupported() {
+		return ""
+	}
+
 	return permissionsS[p]
 }
 
+// IsSupported is used to check if the Permission is mapped in permissionsS
+func (p Permission) IsSupported() bool {
+	return p >= 0 && int(p) < len(permissionsS)
+}
+
 // PermissionFromString is used to get Permission from string value mapped in permissionsS
 func PermissionFromString(s string) (Permission, error) {
 	for i, r := range permissionsS {
@@ -50,10 +59,26 @@ func PermissionFromString(s string) (Permission, error) {
 	return unsupported, errors.GetUnsupportedErr("permissions")
 }
 
+// PermissionFromBytes is used to get Permission from given contract bytes32 permission value
+func PermissionFromBytes(b [32]byte) (Permission, error) {
+	return PermissionFromString(permissionStringFromBytes(b))
+}
+
+// permissionStringFromBytes is used to decode given contract bytes32 permission value to string without trailing zeros
+func permissionStringFromBytes(b [32]byte) string {
+	return strings.TrimRight(string(b[:]), string(rune(0)))
+}
+
+// Bytes is used to get contract bytes32 value of the Permission
+func (p Permission) Bytes() (b [32]byte) {
+	copy(b[:], p.String())
+	return b
+}
+
 // decodePermissions is used to decode given contract permissions to Permission slice
 func decodePermissions(perm perpsMarket.IAccountModuleAccountPermissions) (res []Permission) {
 	for _, b := range perm.Permissions {
-		p, err := PermissionFromString(strings.TrimRight(string(b[:]), string(rune(0))))
+		p, err := PermissionFromBytes(b)
 		if err != nil {
 			logger.Log().WithField("layer", "Model-decodePermissions").Warningf("received unsupported bytes value %v", string(b[:]))
 		} else {
@@ -73,6 +73,19 @@ func TestPermissionFromString(t *testing.T) {
 	}
 }
 
+func TestPermission_Bytes(t *testing.T) {
+	for _, p := range []Permission{ADMIN, WITHDRAW, DELEGATE, MINT, REWARDS, PERPS_MODIFY_COLLATERAL, PERPS_COMMIT_ASYNC_ORDER} {
+		t.Run(p.String(), func(t *testing.T) {
+			res, err := PermissionFromBytes(p.Bytes())
+
+			require.NoError(t, err)
+			require.Equal(t, p, res)
+		})
+	}
+
+	require.Equal(t, [32]byte{65, 68, 77, 73, 78}, ADMIN.Bytes())
+}
+
 func TestDecodePermissions(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// PoolMarketConfiguration is a pool market configuration struct
+//   - MarketId: ID of the market backed by the pool
+//   - WeightD18: Weight of the market in the pool
+//   - MaxDebtShareValueD18: Maximum debt share value of the market, can be negative
+type PoolMarketConfiguration struct {
+	MarketId             *big.Int
+	WeightD18            *big.Int
+	MaxDebtShareValueD18 *big.Int
+}
+
+// PoolConfigurationSet is a `PoolConfigurationSet` Core smart-contract event struct
+type PoolConfigurationSet struct {
+	PoolId          *big.Int
+	Markets         []*PoolMarketConfiguration
+	Sender          common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetPoolConfigurationSetFromEvent is used to get PoolConfigurationSet struct from given contract event
+func GetPoolConfigurationSetFromEvent(event *core.CorePoolConfigurationSet, time uint64) *PoolConfigurationSet {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolConfigurationSet").Warning("nil event received")
+		return &PoolConfigurationSet{}
+	}
+
+	return &PoolConfigurationSet{
+		PoolId:          event.PoolId,
+		Markets:         GetPoolMarketConfigurationsFromContract(event.Markets),
+		Sender:          event.Sender,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// GetPoolMarketConfigurationsFromContract is used to get PoolMarketConfiguration slice from given contract market
+// configurations, the result is never nil
+func GetPoolMarketConfigurationsFromContract(markets []core.MarketConfigurationData) []*PoolMarketConfiguration {
+	res := make([]*PoolMarketConfiguration, 0, len(markets))
+	for _, m := range markets {
+		res = append(res, &PoolMarketConfiguration{
+			MarketId:             m.MarketId,
+			WeightD18:            m.WeightD18,
+			MaxDebtShareValueD18: m.MaxDebtShareValueD18,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetPoolConfigurationSetFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CorePoolConfigurationSet
+		time  uint64
+		want  *PoolConfigurationSet
+	}{
+		{
+			name: "nil event",
+			want: &PoolConfigurationSet{},
+		},
+		{
+			name: "no markets",
+			event: &core.CorePoolConfigurationSet{
+				PoolId: big.NewInt(1),
+			},
+			want: &PoolConfigurationSet{
+				PoolId:          big.NewInt(1),
+				Markets:         []*PoolMarketConfiguration{},
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CorePoolConfigurationSet{
+				PoolId: big.NewInt(1),
+				Markets: []core.MarketConfigurationData{
+					{
+						MarketId:             big.NewInt(1),
+						WeightD18:            big.NewInt(1),
+						MaxDebtShareValueD18: big.NewInt(1000),
+					},
+					{
+						MarketId:             big.NewInt(2),
+						WeightD18:            big.NewInt(3),
+						MaxDebtShareValueD18: big.NewInt(-1),
+					},
+				},
+				Sender: common.BytesToAddress([]byte("sender")),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &PoolConfigurationSet{
+				PoolId: big.NewInt(1),
+				Markets: []*PoolMarketConfiguration{
+					{
+						MarketId:             big.NewInt(1),
+						WeightD18:            big.NewInt(1),
+						MaxDebtShareValueD18: big.NewInt(1000),
+					},
+					{
+						MarketId:             big.NewInt(2),
+						WeightD18:            big.NewInt(3),
+						MaxDebtShareValueD18: big.NewInt(-1),
+					},
+				},
+				Sender:          common.BytesToAddress([]byte("sender")),
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPoolConfigurationSetFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetPoolMarketConfigurationsFromContract(t *testing.T) {
+	testCases := []struct {
+		name    string
+		markets []core.MarketConfigurationData
+		want    []*PoolMarketConfiguration
+	}{
+		{
+			name: "nil markets",
+			want: []*PoolMarketConfiguration{},
+		},
+		{
+			name: "negative max debt share value",
+			markets: []core.MarketConfigurationData{
+				{MarketId: big.NewInt(1), WeightD18: big.NewInt(1), MaxDebtShareValueD18: big.NewInt(-1)},
+			},
+			want: []*PoolMarketConfiguration{
+				{MarketId: big.NewInt(1), WeightD18: big.NewInt(1), MaxDebtShareValueD18: big.NewInt(-1)},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPoolMarketConfigurationsFromContract(tt.markets)
+
+			require.NotNil(t, res)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// PoolCreated is a `PoolCreated` Core smart-contract event struct
+type PoolCreated struct {
+	PoolId          *big.Int
+	Owner           common.Address
+	Sender          common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetPoolCreatedFromEvent is used to get PoolCreated struct from given contract event
+func GetPoolCreatedFromEvent(event *core.CorePoolCreated, time uint64) *PoolCreated {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolCreated").Warning("nil event received")
+		return &PoolCreated{}
+	}
+
+	return &PoolCreated{
+		PoolId:          event.PoolId,
+		Owner:           event.Owner,
+		Sender:          event.Sender,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetPoolCreatedFromEvent(t *testing.T) {
+	filterer, err := core.NewCoreFilterer(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0x48914229deDd5A9922f44441ffCCfC2Cb7856Ee9")
+
+	// PoolCreated log in the optimism goerli core contract format
+	log := types.Log{
+		Address: common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+		Topics: []common.Hash{
+			common.HexToHash("0xb1517ad708e5f9a104c30d3f1ff749d55833b1d03bf472013c29888e741cf340"),
+			common.BigToHash(big.NewInt(1)),
+			common.BytesToHash(owner.Bytes()),
+			common.BytesToHash(owner.Bytes()),
+		},
+		BlockNumber: 11664700,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+	}
+
+	event, err := filterer.ParsePoolCreated(log)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		event *core.CorePoolCreated
+		time  uint64
+		want  *PoolCreated
+	}{
+		{
+			name: "nil event",
+			want: &PoolCreated{},
+		},
+		{
+			name:  "parsed log",
+			event: event,
+			time:  1690000000,
+			want: &PoolCreated{
+				PoolId:          big.NewInt(1),
+				Owner:           owner,
+				Sender:          owner,
+				BlockNumber:     11664700,
+				BlockTimestamp:  1690000000,
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPoolCreatedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
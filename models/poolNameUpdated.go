@@ -0,0 +1,37 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// PoolNameUpdated is a `PoolNameUpdated` Core smart-contract event struct
+type PoolNameUpdated struct {
+	PoolId          *big.Int
+	Name            string
+	Sender          common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetPoolNameUpdatedFromEvent is used to get PoolNameUpdated struct from given contract event
+func GetPoolNameUpdatedFromEvent(event *core.CorePoolNameUpdated, time uint64) *PoolNameUpdated {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolNameUpdated").Warning("nil event received")
+		return &PoolNameUpdated{}
+	}
+
+	return &PoolNameUpdated{
+		PoolId:          event.PoolId,
+		Name:            event.Name,
+		Sender:          event.Sender,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetPoolNameUpdatedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *core.CorePoolNameUpdated
+		time  uint64
+		want  *PoolNameUpdated
+	}{
+		{
+			name: "nil event",
+			want: &PoolNameUpdated{},
+		},
+		{
+			name: "only name",
+			event: &core.CorePoolNameUpdated{
+				Name: "Spartan Council Pool",
+			},
+			want: &PoolNameUpdated{
+				Name:            "Spartan Council Pool",
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &core.CorePoolNameUpdated{
+				PoolId: big.NewInt(1),
+				Name:   "Spartan Council Pool",
+				Sender: common.BytesToAddress([]byte("sender")),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &PoolNameUpdated{
+				PoolId:          big.NewInt(1),
+				Name:            "Spartan Council Pool",
+				Sender:          common.BytesToAddress([]byte("sender")),
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetPoolNameUpdatedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// PoolOwnershipEventKind is a pool ownership change event kind enum
+type PoolOwnershipEventKind int
+
+const (
+	POOL_OWNER_NOMINATED PoolOwnershipEventKind = iota
+	POOL_OWNERSHIP_ACCEPTED
+	POOL_NOMINATION_REVOKED
+	POOL_NOMINATION_RENOUNCED
+)
+
+// poolOwnershipEventKindsS is mapping PoolOwnershipEventKind to its string value
+var poolOwnershipEventKindsS = [...]string{
+	POOL_OWNER_NOMINATED:      "POOL_OWNER_NOMINATED",
+	POOL_OWNERSHIP_ACCEPTED:   "POOL_OWNERSHIP_ACCEPTED",
+	POOL_NOMINATION_REVOKED:   "POOL_NOMINATION_REVOKED",
+	POOL_NOMINATION_RENOUNCED: "POOL_NOMINATION_RENOUNCED",
+}
+
+// String is used to return PoolOwnershipEventKind string value
+func (k PoolOwnershipEventKind) String() string {
+	return poolOwnershipEventKindsS[k]
+}
+
+// PoolOwnershipChange is a unified `PoolOwnerNominated`, `PoolOwnershipAccepted`, `PoolNominationRevoked` and
+// `PoolNominationRenounced` Core smart-contract events struct
+//   - PoolId is an id of the pool
+//   - Kind is a kind of the ownership event
+//   - NominatedOwner is an address of the nominated owner, filled only for POOL_OWNER_NOMINATED events
+//   - Owner is an address emitted with the event: current owner for nominations and revocations, new owner for
+//     acceptances and nominee for renouncements
+//   - BlockNumber is a block number where the ownership was changed
+//   - BlockTimestamp is a timestamp of the block where the ownership was changed
+//   - TransactionHash is a hash of the transaction where the ownership was changed
+//   - LogIndex is an index of the event log in the block
+type PoolOwnershipChange struct {
+	PoolId          *big.Int
+	Kind            PoolOwnershipEventKind
+	NominatedOwner  common.Address
+	Owner           common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+	LogIndex        uint
+}
+
+// GetPoolOwnershipChangeFromNominatedEvent is used to get PoolOwnershipChange struct from given `PoolOwnerNominated`
+// contract event
+func GetPoolOwnershipChangeFromNominatedEvent(event *core.CorePoolOwnerNominated, time uint64) *PoolOwnershipChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolOwnershipChange").Warning("nil nominated event received")
+		return &PoolOwnershipChange{Kind: POOL_OWNER_NOMINATED}
+	}
+
+	return &PoolOwnershipChange{
+		PoolId:          event.PoolId,
+		Kind:            POOL_OWNER_NOMINATED,
+		NominatedOwner:  event.NominatedOwner,
+		Owner:           event.Owner,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+		LogIndex:        event.Raw.Index,
+	}
+}
+
+// GetPoolOwnershipChangeFromAcceptedEvent is used to get PoolOwnershipChange struct from given
+// `PoolOwnershipAccepted` contract event
+func GetPoolOwnershipChangeFromAcceptedEvent(event *core.CorePoolOwnershipAccepted, time uint64) *PoolOwnershipChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolOwnershipChange").Warning("nil accepted event received")
+		return &PoolOwnershipChange{Kind: POOL_OWNERSHIP_ACCEPTED}
+	}
+
+	return getPoolOwnershipChange(event.PoolId, POOL_OWNERSHIP_ACCEPTED, event.Owner, event.Raw, time)
+}
+
+// GetPoolOwnershipChangeFromRevokedEvent is used to get PoolOwnershipChange struct from given
+// `PoolNominationRevoked` contract event
+func GetPoolOwnershipChangeFromRevokedEvent(event *core.CorePoolNominationRevoked, time uint64) *PoolOwnershipChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolOwnershipChange").Warning("nil revoked event received")
+		return &PoolOwnershipChange{Kind: POOL_NOMINATION_REVOKED}
+	}
+
+	return getPoolOwnershipChange(event.PoolId, POOL_NOMINATION_REVOKED, event.Owner, event.Raw, time)
+}
+
+// GetPoolOwnershipChangeFromRenouncedEvent is used to get PoolOwnershipChange struct from given
+// `PoolNominationRenounced` contract event
+func GetPoolOwnershipChangeFromRenouncedEvent(event *core.CorePoolNominationRenounced, time uint64) *PoolOwnershipChange {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PoolOwnershipChange").Warning("nil renounced event received")
+		return &PoolOwnershipChange{Kind: POOL_NOMINATION_RENOUNCED}
+	}
+
+	return getPoolOwnershipChange(event.PoolId, POOL_NOMINATION_RENOUNCED, event.Owner, event.Raw, time)
+}
+
+// getPoolOwnershipChange is used to build PoolOwnershipChange struct from given decoded event values
+func getPoolOwnershipChange(
+	poolID *big.Int,
+	kind PoolOwnershipEventKind,
+	owner common.Address,
+	raw types.Log,
+	time uint64,
+) *PoolOwnershipChange {
+	return &PoolOwnershipChange{
+		PoolId:          poolID,
+		Kind:            kind,
+		Owner:           owner,
+		BlockNumber:     raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: raw.TxHash.Hex(),
+		LogIndex:        raw.Index,
+	}
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetPoolOwnershipChangeFromEvents(t *testing.T) {
+	timeNow := uint64(time.Now().Unix())
+	raw := types.Log{
+		BlockNumber: 2,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+		Index:       4,
+	}
+	want := func(kind PoolOwnershipEventKind, nominee common.Address) *PoolOwnershipChange {
+		return &PoolOwnershipChange{
+			PoolId:          big.NewInt(1),
+			Kind:            kind,
+			NominatedOwner:  nominee,
+			Owner:           common.BytesToAddress([]byte("owner")),
+			BlockNumber:     2,
+			BlockTimestamp:  timeNow,
+			TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			LogIndex:        4,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		res  *PoolOwnershipChange
+		want *PoolOwnershipChange
+	}{
+		{
+			name: "nil nominated event",
+			res:  GetPoolOwnershipChangeFromNominatedEvent(nil, timeNow),
+			want: &PoolOwnershipChange{Kind: POOL_OWNER_NOMINATED},
+		},
+		{
+			name: "nominated event",
+			res: GetPoolOwnershipChangeFromNominatedEvent(&core.CorePoolOwnerNominated{
+				PoolId:         big.NewInt(1),
+				NominatedOwner: common.BytesToAddress([]byte("nominee")),
+				Owner:          common.BytesToAddress([]byte("owner")),
+				Raw:            raw,
+			}, timeNow),
+			want: want(POOL_OWNER_NOMINATED, common.BytesToAddress([]byte("nominee"))),
+		},
+		{
+			name: "nil accepted event",
+			res:  GetPoolOwnershipChangeFromAcceptedEvent(nil, timeNow),
+			want: &PoolOwnershipChange{Kind: POOL_OWNERSHIP_ACCEPTED},
+		},
+		{
+			name: "accepted event",
+			res: GetPoolOwnershipChangeFromAcceptedEvent(&core.CorePoolOwnershipAccepted{
+				PoolId: big.NewInt(1),
+				Owner:  common.BytesToAddress([]byte("owner")),
+				Raw:    raw,
+			}, timeNow),
+			want: want(POOL_OWNERSHIP_ACCEPTED, common.Address{}),
+		},
+		{
+			name: "nil revoked event",
+			res:  GetPoolOwnershipChangeFromRevokedEvent(nil, timeNow),
+			want: &PoolOwnershipChange{Kind: POOL_NOMINATION_REVOKED},
+		},
+		{
+			name: "revoked event",
+			res: GetPoolOwnershipChangeFromRevokedEvent(&core.CorePoolNominationRevoked{
+				PoolId: big.NewInt(1),
+				Owner:  common.BytesToAddress([]byte("owner")),
+				Raw:    raw,
+			}, timeNow),
+			want: want(POOL_NOMINATION_REVOKED, common.Address{}),
+		},
+		{
+			name: "nil renounced event",
+			res:  GetPoolOwnershipChangeFromRenouncedEvent(nil, timeNow),
+			want: &PoolOwnershipChange{Kind: POOL_NOMINATION_RENOUNCED},
+		},
+		{
+			name: "renounced event",
+			res: GetPoolOwnershipChangeFromRenouncedEvent(&core.CorePoolNominationRenounced{
+				PoolId: big.NewInt(1),
+				Owner:  common.BytesToAddress([]byte("owner")),
+				Raw:    raw,
+			}, timeNow),
+			want: want(POOL_NOMINATION_RENOUNCED, common.Address{}),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.res)
+		})
+	}
+}
+
+func TestPoolOwnershipEventKind_String(t *testing.T) {
+	require.Equal(t, "POOL_OWNER_NOMINATED", POOL_OWNER_NOMINATED.String())
+	require.Equal(t, "POOL_NOMINATION_RENOUNCED", POOL_NOMINATION_RENOUNCED.String())
+}
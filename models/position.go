@@ -3,12 +3,14 @@ package models
 import "math/big"
 
 // Position
+//   - MarketID: Represents the ID of the position market.
 //   - TotalPnl: Represents the total profit and loss for the position.
 //   - AccruedFunding: Represents the accrued funding for the position.
 //   - PositionSize: Represents the size of the position.
 //   - BlockNumber: Represents the block number at which the position data was fetched.
 //   - BlockTimestamp: Represents the timestamp of the block at which the position data was fetched.
 type Position struct {
+	MarketID       *big.Int
 	TotalPnl       *big.Int
 	AccruedFunding *big.Int
 	PositionSize   *big.Int
@@ -0,0 +1,23 @@
+package models
+
+import "math/big"
+
+// RequiredMargins is a perps market account required margins data struct
+//   - RequiredInitialMargin: Initial margin required for the account open positions.
+//   - RequiredMaintenanceMargin: Maintenance margin required for the account open positions.
+//   - MaxLiquidationReward: Max reward for the account liquidation.
+type RequiredMargins struct {
+	RequiredInitialMargin     *big.Int
+	RequiredMaintenanceMargin *big.Int
+	MaxLiquidationReward      *big.Int
+}
+
+// GetRequiredMarginsFromContract is used to get RequiredMargins struct from given `getRequiredMargins` contract
+// method outputs
+func GetRequiredMarginsFromContract(initial *big.Int, maintenance *big.Int, maxReward *big.Int) *RequiredMargins {
+	return &RequiredMargins{
+		RequiredInitialMargin:     initial,
+		RequiredMaintenanceMargin: maintenance,
+		MaxLiquidationReward:      maxReward,
+	}
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequiredMarginsFromContract(t *testing.T) {
+	testCases := []struct {
+		name        string
+		initial     *big.Int
+		maintenance *big.Int
+		maxReward   *big.Int
+		want        *RequiredMargins
+	}{
+		{
+			name: "nil values",
+			want: &RequiredMargins{},
+		},
+		{
+			name:        "full values",
+			initial:     big.NewInt(3),
+			maintenance: big.NewInt(2),
+			maxReward:   big.NewInt(1),
+			want: &RequiredMargins{
+				RequiredInitialMargin:     big.NewInt(3),
+				RequiredMaintenanceMargin: big.NewInt(2),
+				MaxLiquidationReward:      big.NewInt(1),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetRequiredMarginsFromContract(tt.initial, tt.maintenance, tt.maxReward)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// SettlementStrategy is a perps market settlement strategy data struct
+//   - StrategyType: Type of the strategy (0 for Pyth at the time of writing).
+//   - SettlementDelay: Delay in seconds after commitment before the order can be settled.
+//   - SettlementWindowDuration: Duration in seconds of the window the order can be settled in.
+//   - PriceVerificationContract: Address of the contract used to verify offchain prices.
+//   - FeedID: Price feed ID used by the strategy.
+//   - SettlementReward: Reward paid to the keeper who settles the order.
+//   - Disabled: Define is the strategy disabled or not.
+//   - CommitmentPriceDelay: Delay in seconds between commitment and the price used for settlement.
+type SettlementStrategy struct {
+	StrategyType              uint8
+	SettlementDelay           *big.Int
+	SettlementWindowDuration  *big.Int
+	PriceVerificationContract common.Address
+	FeedID                    [32]byte
+	SettlementReward          *big.Int
+	Disabled                  bool
+	CommitmentPriceDelay      *big.Int
+}
+
+// SettlementStrategyAdded is a `SettlementStrategyAdded` perps market event model. The same strategy ID can be emitted
+// more than once, every entry holds strategy parameters at the moment of the emission
+//   - MarketID: ID of the market the strategy was added to.
+//   - StrategyID: ID of the added strategy.
+//   - SettlementStrategy: Strategy parameters.
+//   - BlockNumber: Block number where the strategy was added.
+//   - BlockTimestamp: Timestamp of the block where the strategy was added.
+//   - TransactionHash: Hash of the transaction where the strategy was added.
+type SettlementStrategyAdded struct {
+	MarketID   uint64
+	StrategyID uint64
+	SettlementStrategy
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSettlementStrategyFromContract is used to get SettlementStrategy struct from given contract data struct
+func GetSettlementStrategyFromContract(strategy perpsMarket.SettlementStrategyData) SettlementStrategy {
+	return SettlementStrategy{
+		StrategyType:              strategy.StrategyType,
+		SettlementDelay:           strategy.SettlementDelay,
+		SettlementWindowDuration:  strategy.SettlementWindowDuration,
+		PriceVerificationContract: strategy.PriceVerificationContract,
+		FeedID:                    strategy.FeedId,
+		SettlementReward:          strategy.SettlementReward,
+		Disabled:                  strategy.Disabled,
+		CommitmentPriceDelay:      strategy.CommitmentPriceDelay,
+	}
+}
+
+// FeedIDHex is used to get hex encoded FeedID with 0x prefix, ready to be passed to the Pyth price service
+func (s SettlementStrategy) FeedIDHex() string {
+	return hexutil.Encode(s.FeedID[:])
+}
+
+// SpotSettlementStrategy is a spot market settlement strategy data struct
+//   - StrategyType: Type of the strategy (0 for on-chain price, 1 for Pyth at the time of writing).
+//   - SettlementDelay: Delay in seconds after commitment before the order can be settled.
+//   - SettlementWindowDuration: Duration in seconds of the window the order can be settled in.
+//   - PriceVerificationContract: Address of the contract used to verify offchain prices.
+//   - FeedID: Price feed ID used by the strategy.
+//   - URL: Offchain price service URL used to resolve the price for the settlement.
+//   - SettlementReward: Reward paid to the keeper who settles the order.
+//   - PriceDeviationTolerance: Max deviation of the offchain price from the onchain one.
+//   - MinimumUsdExchangeAmount: Min USD amount of the order which can be settled with the strategy.
+//   - MaxRoundingLoss: Max loss allowed due to rounding when the order is settled.
+//   - Disabled: Define is the strategy disabled or not.
+type SpotSettlementStrategy struct {
+	StrategyType              uint8
+	SettlementDelay           *big.Int
+	SettlementWindowDuration  *big.Int
+	PriceVerificationContract common.Address
+	FeedID                    [32]byte
+	URL                       string
+	SettlementReward          *big.Int
+	PriceDeviationTolerance   *big.Int
+	MinimumUsdExchangeAmount  *big.Int
+	MaxRoundingLoss           *big.Int
+	Disabled                  bool
+}
+
+// GetSpotSettlementStrategyFromContract is used to get SpotSettlementStrategy struct from given contract data struct
+func GetSpotSettlementStrategyFromContract(strategy spotMarket.SettlementStrategyData) *SpotSettlementStrategy {
+	return &SpotSettlementStrategy{
+		StrategyType:              strategy.StrategyType,
+		SettlementDelay:           strategy.SettlementDelay,
+		SettlementWindowDuration:  strategy.SettlementWindowDuration,
+		PriceVerificationContract: strategy.PriceVerificationContract,
+		FeedID:                    strategy.FeedId,
+		URL:                       strategy.Url,
+		SettlementReward:          strategy.SettlementReward,
+		PriceDeviationTolerance:   strategy.PriceDeviationTolerance,
+		MinimumUsdExchangeAmount:  strategy.MinimumUsdExchangeAmount,
+		MaxRoundingLoss:           strategy.MaxRoundingLoss,
+		Disabled:                  strategy.Disabled,
+	}
+}
+
+// FeedIDHex is used to get hex encoded FeedID with 0x prefix, ready to be passed to the Pyth price service
+func (s SpotSettlementStrategy) FeedIDHex() string {
+	return hexutil.Encode(s.FeedID[:])
+}
+
+// GetSettlementStrategyAddedFromEvent is used to get SettlementStrategyAdded struct from given event and block timestamp
+func GetSettlementStrategyAddedFromEvent(
+	event *perpsMarket.PerpsMarketSettlementStrategyAdded,
+	time uint64,
+) *SettlementStrategyAdded {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SettlementStrategyAdded").Warning("nil event received")
+		return &SettlementStrategyAdded{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	strategyID := uint64(0)
+	if event.StrategyId != nil {
+		strategyID = event.StrategyId.Uint64()
+	}
+
+	return &SettlementStrategyAdded{
+		MarketID:           marketID,
+		StrategyID:         strategyID,
+		SettlementStrategy: GetSettlementStrategyFromContract(event.Strategy),
+		BlockNumber:        event.Raw.BlockNumber,
+		BlockTimestamp:     time,
+		TransactionHash:    event.Raw.TxHash.Hex(),
+	}
+}
+
+// SettlementStrategyUpdate is a `SettlementStrategySet` perps market event model used to track strategy parameters
+// changes and disabling of the strategy
+//   - MarketID: ID of the market the strategy belongs to.
+//   - StrategyID: ID of the updated strategy.
+//   - SettlementStrategy: New strategy parameters.
+//   - BlockNumber: Block number where the strategy was updated.
+//   - BlockTimestamp: Timestamp of the block where the strategy was updated.
+//   - TransactionHash: Hash of the transaction where the strategy was updated.
+type SettlementStrategyUpdate struct {
+	MarketID   uint64
+	StrategyID uint64
+	SettlementStrategy
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSettlementStrategyUpdateFromEvent is used to get SettlementStrategyUpdate struct from given event and block timestamp
+func GetSettlementStrategyUpdateFromEvent(
+	event *perpsMarket.PerpsMarketSettlementStrategySet,
+	time uint64,
+) *SettlementStrategyUpdate {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SettlementStrategyUpdate").Warning("nil event received")
+		return &SettlementStrategyUpdate{}
+	}
+
+	marketID := uint64(0)
+	if event.MarketId != nil {
+		marketID = event.MarketId.Uint64()
+	}
+
+	strategyID := uint64(0)
+	if event.StrategyId != nil {
+		strategyID = event.StrategyId.Uint64()
+	}
+
+	return &SettlementStrategyUpdate{
+		MarketID:           marketID,
+		StrategyID:         strategyID,
+		SettlementStrategy: GetSettlementStrategyFromContract(event.Strategy),
+		BlockNumber:        event.Raw.BlockNumber,
+		BlockTimestamp:     time,
+		TransactionHash:    event.Raw.TxHash.Hex(),
+	}
+}
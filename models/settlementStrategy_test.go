@@ -0,0 +1,240 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+)
+
+func TestGetSettlementStrategyAddedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	strategy := perpsMarket.SettlementStrategyData{
+		StrategyType:              0,
+		SettlementDelay:           big.NewInt(2),
+		SettlementWindowDuration:  big.NewInt(60),
+		PriceVerificationContract: common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+		FeedId:                    crypto.Keccak256Hash([]byte("feed_id")),
+		SettlementReward:          big.NewInt(1000),
+		CommitmentPriceDelay:      big.NewInt(2),
+	}
+
+	updatedStrategy := strategy
+	updatedStrategy.SettlementWindowDuration = big.NewInt(120)
+	updatedStrategy.Disabled = true
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketSettlementStrategyAdded
+		time  uint64
+		want  *SettlementStrategyAdded
+	}{
+		{
+			name: "nil event",
+			want: &SettlementStrategyAdded{},
+		},
+		{
+			name: "only IDs",
+			event: &perpsMarket.PerpsMarketSettlementStrategyAdded{
+				MarketId:   big.NewInt(100),
+				StrategyId: big.NewInt(1),
+			},
+			want: &SettlementStrategyAdded{
+				MarketID:        100,
+				StrategyID:      1,
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &perpsMarket.PerpsMarketSettlementStrategyAdded{
+				MarketId:   big.NewInt(100),
+				StrategyId: big.NewInt(1),
+				Strategy:   strategy,
+				Raw: types.Log{
+					BlockNumber: 3,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SettlementStrategyAdded{
+				MarketID:   100,
+				StrategyID: 1,
+				SettlementStrategy: SettlementStrategy{
+					StrategyType:              0,
+					SettlementDelay:           big.NewInt(2),
+					SettlementWindowDuration:  big.NewInt(60),
+					PriceVerificationContract: common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+					FeedID:                    crypto.Keccak256Hash([]byte("feed_id")),
+					SettlementReward:          big.NewInt(1000),
+					CommitmentPriceDelay:      big.NewInt(2),
+				},
+				BlockNumber:     3,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+		{
+			name: "same strategy ID re-emitted with changed parameters",
+			event: &perpsMarket.PerpsMarketSettlementStrategyAdded{
+				MarketId:   big.NewInt(100),
+				StrategyId: big.NewInt(1),
+				Strategy:   updatedStrategy,
+				Raw: types.Log{
+					BlockNumber: 4,
+					TxHash:      common.BytesToHash([]byte("tx hash 2")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SettlementStrategyAdded{
+				MarketID:   100,
+				StrategyID: 1,
+				SettlementStrategy: SettlementStrategy{
+					StrategyType:              0,
+					SettlementDelay:           big.NewInt(2),
+					SettlementWindowDuration:  big.NewInt(120),
+					PriceVerificationContract: common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+					FeedID:                    crypto.Keccak256Hash([]byte("feed_id")),
+					SettlementReward:          big.NewInt(1000),
+					Disabled:                  true,
+					CommitmentPriceDelay:      big.NewInt(2),
+				},
+				BlockNumber:     4,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash 2")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSettlementStrategyAddedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSettlementStrategyUpdateFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *perpsMarket.PerpsMarketSettlementStrategySet
+		time  uint64
+		want  *SettlementStrategyUpdate
+	}{
+		{
+			name: "nil event",
+			want: &SettlementStrategyUpdate{},
+		},
+		{
+			name: "strategy disabled",
+			event: &perpsMarket.PerpsMarketSettlementStrategySet{
+				MarketId:   big.NewInt(100),
+				StrategyId: big.NewInt(0),
+				Strategy: perpsMarket.SettlementStrategyData{
+					SettlementDelay:          big.NewInt(2),
+					SettlementWindowDuration: big.NewInt(60),
+					SettlementReward:         big.NewInt(1000),
+					Disabled:                 true,
+				},
+				Raw: types.Log{
+					BlockNumber: 5,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SettlementStrategyUpdate{
+				MarketID:   100,
+				StrategyID: 0,
+				SettlementStrategy: SettlementStrategy{
+					SettlementDelay:          big.NewInt(2),
+					SettlementWindowDuration: big.NewInt(60),
+					SettlementReward:         big.NewInt(1000),
+					Disabled:                 true,
+				},
+				BlockNumber:     5,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSettlementStrategyUpdateFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestSettlementStrategy_FeedIDHex(t *testing.T) {
+	feedID := common.HexToHash("0xff61491a931112ddf1bd8147cd1b641375f79f5825126d665480874634fd0ace")
+
+	testCases := []struct {
+		name     string
+		strategy SettlementStrategy
+		want     string
+	}{
+		{
+			name: "blank feed id",
+			want: "0x0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:     "eth feed id",
+			strategy: SettlementStrategy{FeedID: feedID},
+			want:     "0xff61491a931112ddf1bd8147cd1b641375f79f5825126d665480874634fd0ace",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.strategy.FeedIDHex())
+		})
+	}
+}
+
+func TestGetSpotSettlementStrategyFromContract(t *testing.T) {
+	feedID := common.HexToHash("0xff61491a931112ddf1bd8147cd1b641375f79f5825126d665480874634fd0ace")
+
+	strategy := spotMarket.SettlementStrategyData{
+		StrategyType:              1,
+		SettlementDelay:           big.NewInt(2),
+		SettlementWindowDuration:  big.NewInt(60),
+		PriceVerificationContract: common.HexToAddress("0x8fFFFfd4AfB6115b954Bd326cbe7B4BA576818f6"),
+		FeedId:                    feedID,
+		Url:                       "https://hermes.pyth.network/v2/updates/price/{data}",
+		SettlementReward:          big.NewInt(1000000000000000),
+		PriceDeviationTolerance:   big.NewInt(10000000000000000),
+		MinimumUsdExchangeAmount:  big.NewInt(100000000000000000),
+		MaxRoundingLoss:           big.NewInt(1000000),
+		Disabled:                  true,
+	}
+
+	res := GetSpotSettlementStrategyFromContract(strategy)
+
+	require.Equal(t, &SpotSettlementStrategy{
+		StrategyType:              1,
+		SettlementDelay:           big.NewInt(2),
+		SettlementWindowDuration:  big.NewInt(60),
+		PriceVerificationContract: common.HexToAddress("0x8fFFFfd4AfB6115b954Bd326cbe7B4BA576818f6"),
+		FeedID:                    feedID,
+		URL:                       "https://hermes.pyth.network/v2/updates/price/{data}",
+		SettlementReward:          big.NewInt(1000000000000000),
+		PriceDeviationTolerance:   big.NewInt(10000000000000000),
+		MinimumUsdExchangeAmount:  big.NewInt(100000000000000000),
+		MaxRoundingLoss:           big.NewInt(1000000),
+		Disabled:                  true,
+	}, res)
+	require.Equal(t, "0xff61491a931112ddf1bd8147cd1b641375f79f5825126d665480874634fd0ace", res.FeedIDHex())
+}
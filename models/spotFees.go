@@ -0,0 +1,161 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// SpotFeeKind is a spot market fee configuration kind enum
+type SpotFeeKind int
+
+const (
+	ATOMIC_FIXED_FEE SpotFeeKind = iota
+	ASYNC_FIXED_FEE
+	UTILIZATION_FEE_RATE
+	SKEW_SCALE
+)
+
+// spotFeeKindsS is mapping SpotFeeKind to its string value
+var spotFeeKindsS = [...]string{
+	ATOMIC_FIXED_FEE:     "ATOMIC_FIXED_FEE",
+	ASYNC_FIXED_FEE:      "ASYNC_FIXED_FEE",
+	UTILIZATION_FEE_RATE: "UTILIZATION_FEE_RATE",
+	SKEW_SCALE:           "SKEW_SCALE",
+}
+
+// String is used to return SpotFeeKind string value
+func (k SpotFeeKind) String() string {
+	return spotFeeKindsS[k]
+}
+
+// SpotFeeUpdate is a normalized spot market fee configuration update model, built from the `AtomicFixedFeeSet`,
+// `AsyncFixedFeeSet`, `MarketUtilizationFeesSet` and `MarketSkewScaleSet` events
+//   - SynthMarketID: ID of the synth market.
+//   - FeeKind: Kind of the updated fee configuration.
+//   - Value: New value of the fee configuration.
+//   - BlockNumber: Block number where the fee configuration was updated.
+//   - BlockTimestamp: Timestamp of the block where the fee configuration was updated.
+//   - TransactionHash: Hash of the transaction where the fee configuration was updated.
+//   - LogIndex: Index of the event log in the block, used to order updates from the same block.
+type SpotFeeUpdate struct {
+	SynthMarketID   uint64
+	FeeKind         SpotFeeKind
+	Value           *big.Int
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+	LogIndex        uint
+}
+
+// SpotMarketFees is a spot market current fee configuration model, all values are raw 18 decimals numbers
+//   - SynthMarketID: ID of the synth market.
+//   - AtomicFixedFee: Fixed fee charged for atomic orders.
+//   - AsyncFixedFee: Fixed fee charged for async orders.
+//   - WrapFee: Fee charged for wrapping collateral, can be negative.
+//   - UnwrapFee: Fee charged for unwrapping synth, can be negative.
+//   - UtilizationFeeRate: Utilization fee rate of the market.
+//   - SkewScale: Skew scale of the market.
+type SpotMarketFees struct {
+	SynthMarketID      uint64
+	AtomicFixedFee     *big.Int
+	AsyncFixedFee      *big.Int
+	WrapFee            *big.Int
+	UnwrapFee          *big.Int
+	UtilizationFeeRate *big.Int
+	SkewScale          *big.Int
+}
+
+// GetSpotMarketFees is used to get SpotMarketFees struct from given synth market ID and `getMarketFees`,
+// `getMarketUtilizationFees` and `getMarketSkewScale` contract methods outputs
+func GetSpotMarketFees(
+	synthMarketID *big.Int,
+	fees struct {
+		AtomicFixedFee *big.Int
+		AsyncFixedFee  *big.Int
+		WrapFee        *big.Int
+		UnwrapFee      *big.Int
+	},
+	utilizationFeeRate *big.Int,
+	skewScale *big.Int,
+) *SpotMarketFees {
+	id := uint64(0)
+	if synthMarketID != nil {
+		id = synthMarketID.Uint64()
+	}
+
+	return &SpotMarketFees{
+		SynthMarketID:      id,
+		AtomicFixedFee:     fees.AtomicFixedFee,
+		AsyncFixedFee:      fees.AsyncFixedFee,
+		WrapFee:            fees.WrapFee,
+		UnwrapFee:          fees.UnwrapFee,
+		UtilizationFeeRate: utilizationFeeRate,
+		SkewScale:          skewScale,
+	}
+}
+
+// GetSpotFeeUpdateFromAtomicFixedFeeSetEvent is used to get SpotFeeUpdate struct from given `AtomicFixedFeeSet` event
+// and block timestamp
+func GetSpotFeeUpdateFromAtomicFixedFeeSetEvent(event *spotMarket.SpotMarketAtomicFixedFeeSet, time uint64) *SpotFeeUpdate {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotFeeUpdate").Warning("nil atomic fixed fee event received")
+		return &SpotFeeUpdate{FeeKind: ATOMIC_FIXED_FEE}
+	}
+
+	return getSpotFeeUpdate(event.SynthMarketId, ATOMIC_FIXED_FEE, event.AtomicFixedFee, event.Raw, time)
+}
+
+// GetSpotFeeUpdateFromAsyncFixedFeeSetEvent is used to get SpotFeeUpdate struct from given `AsyncFixedFeeSet` event
+// and block timestamp
+func GetSpotFeeUpdateFromAsyncFixedFeeSetEvent(event *spotMarket.SpotMarketAsyncFixedFeeSet, time uint64) *SpotFeeUpdate {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotFeeUpdate").Warning("nil async fixed fee event received")
+		return &SpotFeeUpdate{FeeKind: ASYNC_FIXED_FEE}
+	}
+
+	return getSpotFeeUpdate(event.SynthMarketId, ASYNC_FIXED_FEE, event.AsyncFixedFee, event.Raw, time)
+}
+
+// GetSpotFeeUpdateFromMarketUtilizationFeesSetEvent is used to get SpotFeeUpdate struct from given
+// `MarketUtilizationFeesSet` event and block timestamp
+func GetSpotFeeUpdateFromMarketUtilizationFeesSetEvent(event *spotMarket.SpotMarketMarketUtilizationFeesSet, time uint64) *SpotFeeUpdate {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotFeeUpdate").Warning("nil market utilization fees event received")
+		return &SpotFeeUpdate{FeeKind: UTILIZATION_FEE_RATE}
+	}
+
+	return getSpotFeeUpdate(event.SynthMarketId, UTILIZATION_FEE_RATE, event.UtilizationFeeRate, event.Raw, time)
+}
+
+// GetSpotFeeUpdateFromMarketSkewScaleSetEvent is used to get SpotFeeUpdate struct from given `MarketSkewScaleSet`
+// event and block timestamp
+func GetSpotFeeUpdateFromMarketSkewScaleSetEvent(event *spotMarket.SpotMarketMarketSkewScaleSet, time uint64) *SpotFeeUpdate {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotFeeUpdate").Warning("nil market skew scale event received")
+		return &SpotFeeUpdate{FeeKind: SKEW_SCALE}
+	}
+
+	return getSpotFeeUpdate(event.SynthMarketId, SKEW_SCALE, event.SkewScale, event.Raw, time)
+}
+
+// getSpotFeeUpdate is used to build SpotFeeUpdate struct from given decoded event values
+func getSpotFeeUpdate(synthMarketID *big.Int, kind SpotFeeKind, value *big.Int, raw types.Log, time uint64) *SpotFeeUpdate {
+	id := uint64(0)
+	if synthMarketID != nil {
+		id = synthMarketID.Uint64()
+	}
+
+	return &SpotFeeUpdate{
+		SynthMarketID:   id,
+		FeeKind:         kind,
+		Value:           value,
+		BlockNumber:     raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: raw.TxHash.Hex(),
+		LogIndex:        raw.Index,
+	}
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+)
+
+func TestSpotFeeKind_String(t *testing.T) {
+	require.Equal(t, "ATOMIC_FIXED_FEE", ATOMIC_FIXED_FEE.String())
+	require.Equal(t, "ASYNC_FIXED_FEE", ASYNC_FIXED_FEE.String())
+	require.Equal(t, "UTILIZATION_FEE_RATE", UTILIZATION_FEE_RATE.String())
+	require.Equal(t, "SKEW_SCALE", SKEW_SCALE.String())
+}
+
+func TestGetSpotFeeUpdateFromEvents(t *testing.T) {
+	timeNow := uint64(time.Now().Unix())
+	raw := types.Log{
+		BlockNumber: 2,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+		Index:       3,
+	}
+	want := func(kind SpotFeeKind) *SpotFeeUpdate {
+		return &SpotFeeUpdate{
+			SynthMarketID:   1,
+			FeeKind:         kind,
+			Value:           big.NewInt(100),
+			BlockNumber:     2,
+			BlockTimestamp:  timeNow,
+			TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			LogIndex:        3,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		res  *SpotFeeUpdate
+		want *SpotFeeUpdate
+	}{
+		{
+			name: "nil atomic fixed fee event",
+			res:  GetSpotFeeUpdateFromAtomicFixedFeeSetEvent(nil, timeNow),
+			want: &SpotFeeUpdate{FeeKind: ATOMIC_FIXED_FEE},
+		},
+		{
+			name: "atomic fixed fee event",
+			res: GetSpotFeeUpdateFromAtomicFixedFeeSetEvent(&spotMarket.SpotMarketAtomicFixedFeeSet{
+				SynthMarketId:  big.NewInt(1),
+				AtomicFixedFee: big.NewInt(100),
+				Raw:            raw,
+			}, timeNow),
+			want: want(ATOMIC_FIXED_FEE),
+		},
+		{
+			name: "nil async fixed fee event",
+			res:  GetSpotFeeUpdateFromAsyncFixedFeeSetEvent(nil, timeNow),
+			want: &SpotFeeUpdate{FeeKind: ASYNC_FIXED_FEE},
+		},
+		{
+			name: "async fixed fee event",
+			res: GetSpotFeeUpdateFromAsyncFixedFeeSetEvent(&spotMarket.SpotMarketAsyncFixedFeeSet{
+				SynthMarketId: big.NewInt(1),
+				AsyncFixedFee: big.NewInt(100),
+				Raw:           raw,
+			}, timeNow),
+			want: want(ASYNC_FIXED_FEE),
+		},
+		{
+			name: "nil market utilization fees event",
+			res:  GetSpotFeeUpdateFromMarketUtilizationFeesSetEvent(nil, timeNow),
+			want: &SpotFeeUpdate{FeeKind: UTILIZATION_FEE_RATE},
+		},
+		{
+			name: "market utilization fees event",
+			res: GetSpotFeeUpdateFromMarketUtilizationFeesSetEvent(&spotMarket.SpotMarketMarketUtilizationFeesSet{
+				SynthMarketId:      big.NewInt(1),
+				UtilizationFeeRate: big.NewInt(100),
+				Raw:                raw,
+			}, timeNow),
+			want: want(UTILIZATION_FEE_RATE),
+		},
+		{
+			name: "nil market skew scale event",
+			res:  GetSpotFeeUpdateFromMarketSkewScaleSetEvent(nil, timeNow),
+			want: &SpotFeeUpdate{FeeKind: SKEW_SCALE},
+		},
+		{
+			name: "market skew scale event",
+			res: GetSpotFeeUpdateFromMarketSkewScaleSetEvent(&spotMarket.SpotMarketMarketSkewScaleSet{
+				SynthMarketId: big.NewInt(1),
+				SkewScale:     big.NewInt(100),
+				Raw:           raw,
+			}, timeNow),
+			want: want(SKEW_SCALE),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.res)
+		})
+	}
+}
+
+func TestGetSpotMarketFees(t *testing.T) {
+	skewScale, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+
+	fees := struct {
+		AtomicFixedFee *big.Int
+		AsyncFixedFee  *big.Int
+		WrapFee        *big.Int
+		UnwrapFee      *big.Int
+	}{
+		AtomicFixedFee: big.NewInt(1000000000000000),
+		AsyncFixedFee:  big.NewInt(500000000000000),
+		WrapFee:        big.NewInt(-100000000000000),
+		UnwrapFee:      big.NewInt(200000000000000),
+	}
+
+	res := GetSpotMarketFees(big.NewInt(1), fees, big.NewInt(10000000000000000), skewScale)
+
+	require.Equal(t, &SpotMarketFees{
+		SynthMarketID:      1,
+		AtomicFixedFee:     big.NewInt(1000000000000000),
+		AsyncFixedFee:      big.NewInt(500000000000000),
+		WrapFee:            big.NewInt(-100000000000000),
+		UnwrapFee:          big.NewInt(200000000000000),
+		UtilizationFeeRate: big.NewInt(10000000000000000),
+		SkewScale:          skewScale,
+	}, res)
+}
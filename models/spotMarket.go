@@ -0,0 +1,306 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// SpotOrderFees is a spot market order fees breakdown struct
+//   - FixedFees: Fixed fees charged for the order.
+//   - UtilizationFees: Utilization fees charged for the order.
+//   - SkewFees: Skew fees charged for the order, can be negative.
+//   - WrapperFees: Wrapper fees charged for the order, can be negative.
+type SpotOrderFees struct {
+	FixedFees       *big.Int
+	UtilizationFees *big.Int
+	SkewFees        *big.Int
+	WrapperFees     *big.Int
+}
+
+// SpotQuote is a spot market quote model
+//   - SynthMarketID: ID of the synth market.
+//   - Amount: Resulting amount of the quote, synth amount for buy exact in and sell exact out quotes, USD amount for
+//     buy exact out and sell exact in quotes.
+//   - Fees: Fees breakdown of the quoted order.
+type SpotQuote struct {
+	SynthMarketID uint64
+	Amount        *big.Int
+	Fees          SpotOrderFees
+}
+
+// SynthBought is a spot market `SynthBought` event model
+//   - SynthMarketID: ID of the synth market.
+//   - AmountReturned: Amount of synth returned to the buyer.
+//   - Fees: Fees breakdown of the order.
+//   - CollectedFees: Fees collected by the fee collector.
+//   - Referrer: Address of the referrer.
+//   - Price: Price of the synth at the time of the order.
+//   - BlockNumber: Block number where the synth was bought.
+//   - BlockTimestamp: Timestamp of the block where the synth was bought.
+//   - TransactionHash: Hash of the transaction where the synth was bought.
+type SynthBought struct {
+	SynthMarketID   uint64
+	AmountReturned  *big.Int
+	Fees            SpotOrderFees
+	CollectedFees   *big.Int
+	Referrer        common.Address
+	Price           *big.Int
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSpotOrderFeesFromContract is used to get SpotOrderFees struct from given contract data struct
+func GetSpotOrderFeesFromContract(fees spotMarket.OrderFeesData) SpotOrderFees {
+	return SpotOrderFees{
+		FixedFees:       fees.FixedFees,
+		UtilizationFees: fees.UtilizationFees,
+		SkewFees:        fees.SkewFees,
+		WrapperFees:     fees.WrapperFees,
+	}
+}
+
+// GetSpotQuote is used to get SpotQuote struct from given synth market ID and spot market quote contract method outputs
+func GetSpotQuote(synthMarketID *big.Int, amount *big.Int, fees spotMarket.OrderFeesData) *SpotQuote {
+	id := uint64(0)
+	if synthMarketID != nil {
+		id = synthMarketID.Uint64()
+	}
+
+	return &SpotQuote{
+		SynthMarketID: id,
+		Amount:        amount,
+		Fees:          GetSpotOrderFeesFromContract(fees),
+	}
+}
+
+// GetSynthBoughtFromEvent is used to get SynthBought struct from given event and block timestamp
+func GetSynthBoughtFromEvent(event *spotMarket.SpotMarketSynthBought, time uint64) *SynthBought {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SynthBought").Warning("nil event received")
+		return &SynthBought{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &SynthBought{
+		SynthMarketID:   synthMarketID,
+		AmountReturned:  event.SynthReturned,
+		Fees:            GetSpotOrderFeesFromContract(event.Fees),
+		CollectedFees:   event.CollectedFees,
+		Referrer:        event.Referrer,
+		Price:           event.Price,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// SynthSold is a spot market `SynthSold` event model
+//   - SynthMarketID: ID of the synth market.
+//   - AmountReturned: Amount of snxUSD returned to the seller.
+//   - Fees: Fees breakdown of the order.
+//   - CollectedFees: Fees collected by the fee collector.
+//   - Referrer: Address of the referrer.
+//   - Price: Price of the synth at the time of the order.
+//   - BlockNumber: Block number where the synth was sold.
+//   - BlockTimestamp: Timestamp of the block where the synth was sold.
+//   - TransactionHash: Hash of the transaction where the synth was sold.
+type SynthSold struct {
+	SynthMarketID   uint64
+	AmountReturned  *big.Int
+	Fees            SpotOrderFees
+	CollectedFees   *big.Int
+	Referrer        common.Address
+	Price           *big.Int
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSynthSoldFromEvent is used to get SynthSold struct from given event and block timestamp
+func GetSynthSoldFromEvent(event *spotMarket.SpotMarketSynthSold, time uint64) *SynthSold {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SynthSold").Warning("nil event received")
+		return &SynthSold{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &SynthSold{
+		SynthMarketID:   synthMarketID,
+		AmountReturned:  event.AmountReturned,
+		Fees:            GetSpotOrderFeesFromContract(event.Fees),
+		CollectedFees:   event.CollectedFees,
+		Referrer:        event.Referrer,
+		Price:           event.Price,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// SynthWrapped is a spot market `SynthWrapped` event model
+//   - SynthMarketID: ID of the synth market.
+//   - AmountWrapped: Amount of synth wrapped.
+//   - Fees: Fees breakdown of the wrap.
+//   - FeesCollected: Fees collected by the fee collector.
+//   - BlockNumber: Block number where the synth was wrapped.
+//   - BlockTimestamp: Timestamp of the block where the synth was wrapped.
+//   - TransactionHash: Hash of the transaction where the synth was wrapped.
+type SynthWrapped struct {
+	SynthMarketID   uint64
+	AmountWrapped   *big.Int
+	Fees            SpotOrderFees
+	FeesCollected   *big.Int
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSynthWrappedFromEvent is used to get SynthWrapped struct from given event and block timestamp
+func GetSynthWrappedFromEvent(event *spotMarket.SpotMarketSynthWrapped, time uint64) *SynthWrapped {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SynthWrapped").Warning("nil event received")
+		return &SynthWrapped{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &SynthWrapped{
+		SynthMarketID:   synthMarketID,
+		AmountWrapped:   event.AmountWrapped,
+		Fees:            GetSpotOrderFeesFromContract(event.Fees),
+		FeesCollected:   event.FeesCollected,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// SynthUnwrapped is a spot market `SynthUnwrapped` event model
+//   - SynthMarketID: ID of the synth market.
+//   - AmountUnwrapped: Amount of synth unwrapped.
+//   - Fees: Fees breakdown of the unwrap.
+//   - FeesCollected: Fees collected by the fee collector.
+//   - BlockNumber: Block number where the synth was unwrapped.
+//   - BlockTimestamp: Timestamp of the block where the synth was unwrapped.
+//   - TransactionHash: Hash of the transaction where the synth was unwrapped.
+type SynthUnwrapped struct {
+	SynthMarketID   uint64
+	AmountUnwrapped *big.Int
+	Fees            SpotOrderFees
+	FeesCollected   *big.Int
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSynthUnwrappedFromEvent is used to get SynthUnwrapped struct from given event and block timestamp
+func GetSynthUnwrappedFromEvent(event *spotMarket.SpotMarketSynthUnwrapped, time uint64) *SynthUnwrapped {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SynthUnwrapped").Warning("nil event received")
+		return &SynthUnwrapped{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &SynthUnwrapped{
+		SynthMarketID:   synthMarketID,
+		AmountUnwrapped: event.AmountUnwrapped,
+		Fees:            GetSpotOrderFeesFromContract(event.Fees),
+		FeesCollected:   event.FeesCollected,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// SynthRegistered is a spot market `SynthRegistered` event model
+//   - SynthMarketID: ID of the registered synth market.
+//   - SynthTokenAddress: Address of the synth token.
+//   - BlockNumber: Block number where the synth was registered.
+//   - BlockTimestamp: Timestamp of the block where the synth was registered.
+//   - TransactionHash: Hash of the transaction where the synth was registered.
+type SynthRegistered struct {
+	SynthMarketID     uint64
+	SynthTokenAddress common.Address
+	BlockNumber       uint64
+	BlockTimestamp    uint64
+	TransactionHash   string
+}
+
+// GetSynthRegisteredFromEvent is used to get SynthRegistered struct from given event and block timestamp
+func GetSynthRegisteredFromEvent(event *spotMarket.SpotMarketSynthRegistered, time uint64) *SynthRegistered {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SynthRegistered").Warning("nil event received")
+		return &SynthRegistered{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &SynthRegistered{
+		SynthMarketID:     synthMarketID,
+		SynthTokenAddress: event.SynthTokenAddress,
+		BlockNumber:       event.Raw.BlockNumber,
+		BlockTimestamp:    time,
+		TransactionHash:   event.Raw.TxHash.Hex(),
+	}
+}
+
+// WrapperSet is a spot market `WrapperSet` event model
+//   - SynthMarketID: ID of the synth market.
+//   - WrapCollateralType: Address of the collateral used to wrap the synth.
+//   - MaxWrappableAmount: Maximum amount of collateral that can be wrapped.
+//   - BlockNumber: Block number where the wrapper was set.
+//   - BlockTimestamp: Timestamp of the block where the wrapper was set.
+//   - TransactionHash: Hash of the transaction where the wrapper was set.
+type WrapperSet struct {
+	SynthMarketID      uint64
+	WrapCollateralType common.Address
+	MaxWrappableAmount *big.Int
+	BlockNumber        uint64
+	BlockTimestamp     uint64
+	TransactionHash    string
+}
+
+// GetWrapperSetFromEvent is used to get WrapperSet struct from given event and block timestamp
+func GetWrapperSetFromEvent(event *spotMarket.SpotMarketWrapperSet, time uint64) *WrapperSet {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-WrapperSet").Warning("nil event received")
+		return &WrapperSet{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.SynthMarketId != nil {
+		synthMarketID = event.SynthMarketId.Uint64()
+	}
+
+	return &WrapperSet{
+		SynthMarketID:      synthMarketID,
+		WrapCollateralType: event.WrapCollateralType,
+		MaxWrappableAmount: event.MaxWrappableAmount,
+		BlockNumber:        event.Raw.BlockNumber,
+		BlockTimestamp:     time,
+		TransactionHash:    event.Raw.TxHash.Hex(),
+	}
+}
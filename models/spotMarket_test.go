@@ -0,0 +1,444 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+)
+
+func TestGetSynthBoughtFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketSynthBought
+		time  uint64
+		want  *SynthBought
+	}{
+		{
+			name: "nil event",
+			want: &SynthBought{},
+		},
+		{
+			name: "only synth market ID",
+			event: &spotMarket.SpotMarketSynthBought{
+				SynthMarketId: big.NewInt(1),
+			},
+			want: &SynthBought{
+				SynthMarketID:   1,
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketSynthBought{
+				SynthMarketId: big.NewInt(1),
+				SynthReturned: big.NewInt(2),
+				Fees: spotMarket.OrderFeesData{
+					FixedFees:       big.NewInt(3),
+					UtilizationFees: big.NewInt(4),
+					SkewFees:        big.NewInt(-5),
+					WrapperFees:     big.NewInt(6),
+				},
+				CollectedFees: big.NewInt(7),
+				Referrer:      common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Price:         big.NewInt(8),
+				Raw: types.Log{
+					BlockNumber: 9,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SynthBought{
+				SynthMarketID:  1,
+				AmountReturned: big.NewInt(2),
+				Fees: SpotOrderFees{
+					FixedFees:       big.NewInt(3),
+					UtilizationFees: big.NewInt(4),
+					SkewFees:        big.NewInt(-5),
+					WrapperFees:     big.NewInt(6),
+				},
+				CollectedFees:   big.NewInt(7),
+				Referrer:        common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Price:           big.NewInt(8),
+				BlockNumber:     9,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSynthBoughtFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSynthSoldFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketSynthSold
+		time  uint64
+		want  *SynthSold
+	}{
+		{
+			name: "nil event",
+			want: &SynthSold{},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketSynthSold{
+				SynthMarketId:  big.NewInt(1),
+				AmountReturned: big.NewInt(2),
+				Fees: spotMarket.OrderFeesData{
+					FixedFees:       big.NewInt(3),
+					UtilizationFees: big.NewInt(4),
+					SkewFees:        big.NewInt(-5),
+					WrapperFees:     big.NewInt(6),
+				},
+				CollectedFees: big.NewInt(7),
+				Referrer:      common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Price:         big.NewInt(8),
+				Raw: types.Log{
+					BlockNumber: 9,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SynthSold{
+				SynthMarketID:  1,
+				AmountReturned: big.NewInt(2),
+				Fees: SpotOrderFees{
+					FixedFees:       big.NewInt(3),
+					UtilizationFees: big.NewInt(4),
+					SkewFees:        big.NewInt(-5),
+					WrapperFees:     big.NewInt(6),
+				},
+				CollectedFees:   big.NewInt(7),
+				Referrer:        common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				Price:           big.NewInt(8),
+				BlockNumber:     9,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSynthSoldFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSynthWrappedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketSynthWrapped
+		time  uint64
+		want  *SynthWrapped
+	}{
+		{
+			name: "nil event",
+			want: &SynthWrapped{},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketSynthWrapped{
+				SynthMarketId: big.NewInt(1),
+				AmountWrapped: big.NewInt(2),
+				Fees: spotMarket.OrderFeesData{
+					FixedFees:       big.NewInt(0),
+					UtilizationFees: big.NewInt(0),
+					SkewFees:        big.NewInt(0),
+					WrapperFees:     big.NewInt(3),
+				},
+				FeesCollected: big.NewInt(3),
+				Raw: types.Log{
+					BlockNumber: 4,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SynthWrapped{
+				SynthMarketID: 1,
+				AmountWrapped: big.NewInt(2),
+				Fees: SpotOrderFees{
+					FixedFees:       big.NewInt(0),
+					UtilizationFees: big.NewInt(0),
+					SkewFees:        big.NewInt(0),
+					WrapperFees:     big.NewInt(3),
+				},
+				FeesCollected:   big.NewInt(3),
+				BlockNumber:     4,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSynthWrappedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSynthUnwrappedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketSynthUnwrapped
+		time  uint64
+		want  *SynthUnwrapped
+	}{
+		{
+			name: "nil event",
+			want: &SynthUnwrapped{},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketSynthUnwrapped{
+				SynthMarketId:   big.NewInt(1),
+				AmountUnwrapped: big.NewInt(2),
+				Fees: spotMarket.OrderFeesData{
+					FixedFees:       big.NewInt(0),
+					UtilizationFees: big.NewInt(0),
+					SkewFees:        big.NewInt(0),
+					WrapperFees:     big.NewInt(3),
+				},
+				FeesCollected: big.NewInt(3),
+				Raw: types.Log{
+					BlockNumber: 4,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SynthUnwrapped{
+				SynthMarketID:   1,
+				AmountUnwrapped: big.NewInt(2),
+				Fees: SpotOrderFees{
+					FixedFees:       big.NewInt(0),
+					UtilizationFees: big.NewInt(0),
+					SkewFees:        big.NewInt(0),
+					WrapperFees:     big.NewInt(3),
+				},
+				FeesCollected:   big.NewInt(3),
+				BlockNumber:     4,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSynthUnwrappedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSynthRegisteredFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketSynthRegistered
+		time  uint64
+		want  *SynthRegistered
+	}{
+		{
+			name: "nil event",
+			want: &SynthRegistered{},
+		},
+		{
+			name: "only synth token address",
+			event: &spotMarket.SpotMarketSynthRegistered{
+				SynthTokenAddress: common.BytesToAddress([]byte("synth")),
+			},
+			want: &SynthRegistered{
+				SynthTokenAddress: common.BytesToAddress([]byte("synth")),
+				TransactionHash:   common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketSynthRegistered{
+				SynthMarketId:     big.NewInt(4),
+				SynthTokenAddress: common.BytesToAddress([]byte("synth")),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SynthRegistered{
+				SynthMarketID:     4,
+				SynthTokenAddress: common.BytesToAddress([]byte("synth")),
+				BlockNumber:       2,
+				BlockTimestamp:    uint64(timeNow.Unix()),
+				TransactionHash:   common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSynthRegisteredFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetWrapperSetFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketWrapperSet
+		time  uint64
+		want  *WrapperSet
+	}{
+		{
+			name: "nil event",
+			want: &WrapperSet{},
+		},
+		{
+			name: "only max wrappable amount",
+			event: &spotMarket.SpotMarketWrapperSet{
+				MaxWrappableAmount: big.NewInt(100),
+			},
+			want: &WrapperSet{
+				MaxWrappableAmount: big.NewInt(100),
+				TransactionHash:    common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketWrapperSet{
+				SynthMarketId:      big.NewInt(1),
+				WrapCollateralType: common.BytesToAddress([]byte("usdc")),
+				MaxWrappableAmount: big.NewInt(100),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &WrapperSet{
+				SynthMarketID:      1,
+				WrapCollateralType: common.BytesToAddress([]byte("usdc")),
+				MaxWrappableAmount: big.NewInt(100),
+				BlockNumber:        2,
+				BlockTimestamp:     uint64(timeNow.Unix()),
+				TransactionHash:    common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetWrapperSetFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetWrapperSetFromEvent_RawLog(t *testing.T) {
+	filterer, err := spotMarket.NewSpotMarketFilterer(common.Address{}, nil)
+	require.NoError(t, err)
+
+	usdc := common.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")
+	maxWrappable, _ := new(big.Int).SetString("1000000000000000000000000000", 10)
+
+	log := types.Log{
+		Topics: []common.Hash{
+			common.HexToHash("0xf6b8d296783aecfc5d372dff3e3e802ab63338637f9a2f3e2aae1e745c148def"),
+			common.BigToHash(big.NewInt(1)),
+			common.BytesToHash(usdc.Bytes()),
+		},
+		Data:        common.LeftPadBytes(maxWrappable.Bytes(), 32),
+		BlockNumber: 13044300,
+		TxHash:      common.HexToHash("0x3f1c5e0b8cb1b1e4a6c7ad0f2b0c4fd3b5f0e07b8d0b05a5c1c2f6b4e1d3a9c7"),
+	}
+
+	event, err := filterer.ParseWrapperSet(log)
+	require.NoError(t, err)
+
+	res := GetWrapperSetFromEvent(event, 1700000000)
+
+	require.Equal(t, &WrapperSet{
+		SynthMarketID:      1,
+		WrapCollateralType: usdc,
+		MaxWrappableAmount: maxWrappable,
+		BlockNumber:        13044300,
+		BlockTimestamp:     1700000000,
+		TransactionHash:    "0x3f1c5e0b8cb1b1e4a6c7ad0f2b0c4fd3b5f0e07b8d0b05a5c1c2f6b4e1d3a9c7",
+	}, res)
+}
+
+func TestGetSpotQuote(t *testing.T) {
+	testCases := []struct {
+		name   string
+		id     *big.Int
+		amount *big.Int
+		fees   spotMarket.OrderFeesData
+		want   *SpotQuote
+	}{
+		{
+			name: "nil values",
+			want: &SpotQuote{},
+		},
+		{
+			name:   "full quote",
+			id:     big.NewInt(1),
+			amount: big.NewInt(100),
+			fees: spotMarket.OrderFeesData{
+				FixedFees:       big.NewInt(1),
+				UtilizationFees: big.NewInt(2),
+				SkewFees:        big.NewInt(-3),
+				WrapperFees:     big.NewInt(4),
+			},
+			want: &SpotQuote{
+				SynthMarketID: 1,
+				Amount:        big.NewInt(100),
+				Fees: SpotOrderFees{
+					FixedFees:       big.NewInt(1),
+					UtilizationFees: big.NewInt(2),
+					SkewFees:        big.NewInt(-3),
+					WrapperFees:     big.NewInt(4),
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSpotQuote(tt.id, tt.amount, tt.fees)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// SpotOrderCommitted is a spot market async `OrderCommitted` event model
+//   - SynthMarketID: ID of the synth market used for the order.
+//   - OrderType: Represents the transaction type (buy, sell, wrap, unwrap, async buy, async sell).
+//   - AmountProvided: Amount of value provided by the user for the order.
+//   - AsyncOrderID: ID of the async order.
+//   - Sender: Address of the sender of the order.
+//   - Referrer: Address of the referrer of the order.
+//   - BlockNumber: Block number where the order was committed.
+//   - BlockTimestamp: Timestamp of the block where the order was committed.
+//   - TransactionHash: Hash of the transaction where the order was committed.
+type SpotOrderCommitted struct {
+	SynthMarketID   uint64
+	OrderType       uint8
+	AmountProvided  *big.Int
+	AsyncOrderID    uint64
+	Sender          common.Address
+	Referrer        common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSpotOrderCommittedFromEvent is used to get SpotOrderCommitted struct from given event and block timestamp
+func GetSpotOrderCommittedFromEvent(event *spotMarket.SpotMarketOrderCommitted, time uint64) *SpotOrderCommitted {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotOrderCommitted").Warning("nil event received")
+		return &SpotOrderCommitted{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.MarketId != nil {
+		synthMarketID = event.MarketId.Uint64()
+	}
+
+	asyncOrderID := uint64(0)
+	if event.AsyncOrderId != nil {
+		asyncOrderID = event.AsyncOrderId.Uint64()
+	}
+
+	return &SpotOrderCommitted{
+		SynthMarketID:   synthMarketID,
+		OrderType:       event.OrderType,
+		AmountProvided:  event.AmountProvided,
+		AsyncOrderID:    asyncOrderID,
+		Sender:          event.Sender,
+		Referrer:        event.Referrer,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
+
+// SpotOrderSettled is a spot market async `OrderSettled` event model
+//   - SynthMarketID: ID of the synth market used for the order.
+//   - AsyncOrderID: ID of the async order, matches SpotOrderCommitted.AsyncOrderID.
+//   - FinalOrderAmount: Amount returned to the trader after fees.
+//   - Fees: Fees breakdown of the order.
+//   - CollectedFees: Fees collected by the fee collector.
+//   - Settler: Address of the settler of the order.
+//   - Price: Price of the synth at the time of the settlement.
+//   - OrderType: Represents the transaction type (buy, sell, wrap, unwrap, async buy, async sell).
+//   - BlockNumber: Block number where the order was settled.
+//   - BlockTimestamp: Timestamp of the block where the order was settled.
+//   - TransactionHash: Hash of the transaction where the order was settled.
+type SpotOrderSettled struct {
+	SynthMarketID    uint64
+	AsyncOrderID     uint64
+	FinalOrderAmount *big.Int
+	Fees             SpotOrderFees
+	CollectedFees    *big.Int
+	Settler          common.Address
+	Price            *big.Int
+	OrderType        uint8
+	BlockNumber      uint64
+	BlockTimestamp   uint64
+	TransactionHash  string
+}
+
+// GetSpotOrderSettledFromEvent is used to get SpotOrderSettled struct from given event and block timestamp
+func GetSpotOrderSettledFromEvent(event *spotMarket.SpotMarketOrderSettled, time uint64) *SpotOrderSettled {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotOrderSettled").Warning("nil event received")
+		return &SpotOrderSettled{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.MarketId != nil {
+		synthMarketID = event.MarketId.Uint64()
+	}
+
+	asyncOrderID := uint64(0)
+	if event.AsyncOrderId != nil {
+		asyncOrderID = event.AsyncOrderId.Uint64()
+	}
+
+	return &SpotOrderSettled{
+		SynthMarketID:    synthMarketID,
+		AsyncOrderID:     asyncOrderID,
+		FinalOrderAmount: event.FinalOrderAmount,
+		Fees:             GetSpotOrderFeesFromContract(event.Fees),
+		CollectedFees:    event.CollectedFees,
+		Settler:          event.Settler,
+		Price:            event.Price,
+		OrderType:        event.OrderType,
+		BlockNumber:      event.Raw.BlockNumber,
+		BlockTimestamp:   time,
+		TransactionHash:  event.Raw.TxHash.Hex(),
+	}
+}
+
+// SpotAsyncOrderClaim is a spot market async order claim struct
+//   - ID: ID of the async order claim.
+//   - Owner: Address of the owner of the order.
+//   - OrderType: Represents the transaction type (buy, sell, wrap, unwrap, async buy, async sell).
+//   - AmountEscrowed: Amount escrowed from the trader for the order.
+//   - SettlementStrategyID: ID of the settlement strategy used for the order.
+//   - CommitmentTime: Time at which the order was committed.
+//   - MinimumSettlementAmount: Minimum amount the trader is willing to accept on settlement.
+//   - SettledAt: Time at which the order was settled.
+//   - Referrer: Address of the referrer of the order.
+type SpotAsyncOrderClaim struct {
+	ID                      uint64
+	Owner                   common.Address
+	OrderType               uint8
+	AmountEscrowed          *big.Int
+	SettlementStrategyID    uint64
+	CommitmentTime          uint64
+	MinimumSettlementAmount *big.Int
+	SettledAt               uint64
+	Referrer                common.Address
+}
+
+// SpotOrderCancelled is a spot market async `OrderCancelled` event model
+//   - SynthMarketID: ID of the synth market used for the order.
+//   - AsyncOrderID: ID of the async order, matches SpotOrderCommitted.AsyncOrderID.
+//   - AsyncOrderClaim: Details of the cancelled order claim.
+//   - Sender: Address of the sender of the cancellation.
+//   - BlockNumber: Block number where the order was cancelled.
+//   - BlockTimestamp: Timestamp of the block where the order was cancelled.
+//   - TransactionHash: Hash of the transaction where the order was cancelled.
+type SpotOrderCancelled struct {
+	SynthMarketID   uint64
+	AsyncOrderID    uint64
+	AsyncOrderClaim SpotAsyncOrderClaim
+	Sender          common.Address
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+}
+
+// GetSpotAsyncOrderClaimFromContract is used to get SpotAsyncOrderClaim struct from given contract data struct
+func GetSpotAsyncOrderClaimFromContract(claim spotMarket.AsyncOrderClaimData) SpotAsyncOrderClaim {
+	id := uint64(0)
+	if claim.Id != nil {
+		id = claim.Id.Uint64()
+	}
+
+	settlementStrategyID := uint64(0)
+	if claim.SettlementStrategyId != nil {
+		settlementStrategyID = claim.SettlementStrategyId.Uint64()
+	}
+
+	commitmentTime := uint64(0)
+	if claim.CommitmentTime != nil {
+		commitmentTime = claim.CommitmentTime.Uint64()
+	}
+
+	settledAt := uint64(0)
+	if claim.SettledAt != nil {
+		settledAt = claim.SettledAt.Uint64()
+	}
+
+	return SpotAsyncOrderClaim{
+		ID:                      id,
+		Owner:                   claim.Owner,
+		OrderType:               claim.OrderType,
+		AmountEscrowed:          claim.AmountEscrowed,
+		SettlementStrategyID:    settlementStrategyID,
+		CommitmentTime:          commitmentTime,
+		MinimumSettlementAmount: claim.MinimumSettlementAmount,
+		SettledAt:               settledAt,
+		Referrer:                claim.Referrer,
+	}
+}
+
+// GetSpotOrderCancelledFromEvent is used to get SpotOrderCancelled struct from given event and block timestamp
+func GetSpotOrderCancelledFromEvent(event *spotMarket.SpotMarketOrderCancelled, time uint64) *SpotOrderCancelled {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-SpotOrderCancelled").Warning("nil event received")
+		return &SpotOrderCancelled{}
+	}
+
+	synthMarketID := uint64(0)
+	if event.MarketId != nil {
+		synthMarketID = event.MarketId.Uint64()
+	}
+
+	asyncOrderID := uint64(0)
+	if event.AsyncOrderId != nil {
+		asyncOrderID = event.AsyncOrderId.Uint64()
+	}
+
+	return &SpotOrderCancelled{
+		SynthMarketID:   synthMarketID,
+		AsyncOrderID:    asyncOrderID,
+		AsyncOrderClaim: GetSpotAsyncOrderClaimFromContract(event.AsyncOrderClaim),
+		Sender:          event.Sender,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+	}
+}
@@ -0,0 +1,226 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+)
+
+func TestGetSpotOrderCommittedFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketOrderCommitted
+		time  uint64
+		want  *SpotOrderCommitted
+	}{
+		{
+			name: "nil event",
+			want: &SpotOrderCommitted{},
+		},
+		{
+			name: "only amount provided",
+			event: &spotMarket.SpotMarketOrderCommitted{
+				AmountProvided: big.NewInt(100),
+			},
+			want: &SpotOrderCommitted{
+				AmountProvided:  big.NewInt(100),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketOrderCommitted{
+				MarketId:       big.NewInt(1),
+				OrderType:      3,
+				AmountProvided: big.NewInt(100),
+				AsyncOrderId:   big.NewInt(7),
+				Sender:         common.BytesToAddress([]byte("sender")),
+				Referrer:       common.BytesToAddress([]byte("referrer")),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SpotOrderCommitted{
+				SynthMarketID:   1,
+				OrderType:       3,
+				AmountProvided:  big.NewInt(100),
+				AsyncOrderID:    7,
+				Sender:          common.BytesToAddress([]byte("sender")),
+				Referrer:        common.BytesToAddress([]byte("referrer")),
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSpotOrderCommittedFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSpotOrderSettledFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketOrderSettled
+		time  uint64
+		want  *SpotOrderSettled
+	}{
+		{
+			name: "nil event",
+			want: &SpotOrderSettled{},
+		},
+		{
+			name: "only final order amount",
+			event: &spotMarket.SpotMarketOrderSettled{
+				FinalOrderAmount: big.NewInt(100),
+			},
+			want: &SpotOrderSettled{
+				FinalOrderAmount: big.NewInt(100),
+				TransactionHash:  common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketOrderSettled{
+				MarketId:         big.NewInt(1),
+				AsyncOrderId:     big.NewInt(7),
+				FinalOrderAmount: big.NewInt(100),
+				Fees: spotMarket.OrderFeesData{
+					FixedFees:       big.NewInt(1),
+					UtilizationFees: big.NewInt(2),
+					SkewFees:        big.NewInt(-3),
+					WrapperFees:     big.NewInt(0),
+				},
+				CollectedFees: big.NewInt(4),
+				Settler:       common.BytesToAddress([]byte("settler")),
+				Price:         big.NewInt(1500),
+				OrderType:     3,
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SpotOrderSettled{
+				SynthMarketID:    1,
+				AsyncOrderID:     7,
+				FinalOrderAmount: big.NewInt(100),
+				Fees: SpotOrderFees{
+					FixedFees:       big.NewInt(1),
+					UtilizationFees: big.NewInt(2),
+					SkewFees:        big.NewInt(-3),
+					WrapperFees:     big.NewInt(0),
+				},
+				CollectedFees:   big.NewInt(4),
+				Settler:         common.BytesToAddress([]byte("settler")),
+				Price:           big.NewInt(1500),
+				OrderType:       3,
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSpotOrderSettledFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestGetSpotOrderCancelledFromEvent(t *testing.T) {
+	timeNow := time.Now()
+
+	testCases := []struct {
+		name  string
+		event *spotMarket.SpotMarketOrderCancelled
+		time  uint64
+		want  *SpotOrderCancelled
+	}{
+		{
+			name: "nil event",
+			want: &SpotOrderCancelled{},
+		},
+		{
+			name: "only sender",
+			event: &spotMarket.SpotMarketOrderCancelled{
+				Sender: common.BytesToAddress([]byte("sender")),
+			},
+			want: &SpotOrderCancelled{
+				Sender:          common.BytesToAddress([]byte("sender")),
+				TransactionHash: common.BytesToHash([]byte("")).Hex(),
+			},
+		},
+		{
+			name: "full event",
+			event: &spotMarket.SpotMarketOrderCancelled{
+				MarketId:     big.NewInt(1),
+				AsyncOrderId: big.NewInt(7),
+				AsyncOrderClaim: spotMarket.AsyncOrderClaimData{
+					Id:                      big.NewInt(7),
+					Owner:                   common.BytesToAddress([]byte("owner")),
+					OrderType:               3,
+					AmountEscrowed:          big.NewInt(100),
+					SettlementStrategyId:    big.NewInt(2),
+					CommitmentTime:          big.NewInt(1700000000),
+					MinimumSettlementAmount: big.NewInt(90),
+					SettledAt:               big.NewInt(0),
+					Referrer:                common.BytesToAddress([]byte("referrer")),
+				},
+				Sender: common.BytesToAddress([]byte("sender")),
+				Raw: types.Log{
+					BlockNumber: 2,
+					TxHash:      common.BytesToHash([]byte("tx hash")),
+				},
+			},
+			time: uint64(timeNow.Unix()),
+			want: &SpotOrderCancelled{
+				SynthMarketID: 1,
+				AsyncOrderID:  7,
+				AsyncOrderClaim: SpotAsyncOrderClaim{
+					ID:                      7,
+					Owner:                   common.BytesToAddress([]byte("owner")),
+					OrderType:               3,
+					AmountEscrowed:          big.NewInt(100),
+					SettlementStrategyID:    2,
+					CommitmentTime:          1700000000,
+					MinimumSettlementAmount: big.NewInt(90),
+					SettledAt:               0,
+					Referrer:                common.BytesToAddress([]byte("referrer")),
+				},
+				Sender:          common.BytesToAddress([]byte("sender")),
+				BlockNumber:     2,
+				BlockTimestamp:  uint64(timeNow.Unix()),
+				TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := GetSpotOrderCancelledFromEvent(tt.event, tt.time)
+
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// USDIssuance is a common data struct of the `usdMinted` and `usdBurned` Core smart-contract events. It is embedded in
+// USDMinted and USDBurned so both event streams can be processed generically
+type USDIssuance struct {
+	AccountId      *big.Int
+	PoolId         *big.Int
+	CollateralType common.Address
+	Amount         *big.Int
+	Sender         common.Address
+	BlockNumber    uint64
+	BlockTimestamp uint64
+}
+
+// GetIssuance is used to get common USDIssuance data struct
+func (i *USDIssuance) GetIssuance() *USDIssuance {
+	return i
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+)
+
+func TestGetUSDIssuanceFromEvents(t *testing.T) {
+	timeNow := uint64(time.Now().Unix())
+
+	want := &USDIssuance{
+		AccountId:      big.NewInt(1),
+		PoolId:         big.NewInt(2),
+		CollateralType: common.BytesToAddress([]byte("collateral")),
+		Amount:         big.NewInt(100),
+		Sender:         common.BytesToAddress([]byte("sender")),
+		BlockNumber:    3,
+		BlockTimestamp: timeNow,
+	}
+	raw := types.Log{BlockNumber: 3}
+
+	minted := GetUSDMintedFromEvent(&core.CoreUsdMinted{
+		AccountId:      big.NewInt(1),
+		PoolId:         big.NewInt(2),
+		CollateralType: common.BytesToAddress([]byte("collateral")),
+		Amount:         big.NewInt(100),
+		Sender:         common.BytesToAddress([]byte("sender")),
+		Raw:            raw,
+	}, timeNow)
+
+	burned := GetUSDBurnedFromEvent(&core.CoreUsdBurned{
+		AccountId:      big.NewInt(1),
+		PoolId:         big.NewInt(2),
+		CollateralType: common.BytesToAddress([]byte("collateral")),
+		Amount:         big.NewInt(100),
+		Sender:         common.BytesToAddress([]byte("sender")),
+		Raw:            raw,
+	}, timeNow)
+
+	for _, res := range []interface{ GetIssuance() *USDIssuance }{minted, burned} {
+		require.Equal(t, want, res.GetIssuance())
+	}
+
+	require.Equal(t, &USDMinted{}, GetUSDMintedFromEvent(nil, timeNow))
+	require.Equal(t, &USDBurned{}, GetUSDBurnedFromEvent(nil, timeNow))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
 
 // UserPermissions is a struct for permissions granted by account owner to User with a list of Permissions
@@ -13,15 +14,89 @@ type UserPermissions struct {
 	Permissions []Permission
 }
 
+// AccountPermission is a struct for permissions granted by account owner to User with a list of decoded Permissions
+// string values. Permissions unknown to the Permission enum are kept as decoded raw strings
+type AccountPermission struct {
+	User        common.Address
+	Permissions []string
+}
+
 // PermissionChanged is a struct for `PermissionRevoked` and `PermissionGranted` contract events
+//   - AccountID is an account NFT id
+//   - User is an address of the user whose permission was changed
+//   - Permission is a changed permission. Permissions unknown to the lib are kept with Permission.IsSupported false
+//   - RawPermission is a changed permission decoded from contract bytes32 value as is
+//   - Sender is an address of the sender of the change
+//   - Granted is true for `PermissionGranted` event and false for `PermissionRevoked` event
+//   - BlockNumber is a block number where the permission was changed
+//   - BlockTimestamp is a timestamp of the block where the permission was changed
+//   - TransactionHash is a hash of the transaction where the permission was changed
+//   - LogIndex is an index of the event log in the block
 type PermissionChanged struct {
-	AccountID  *big.Int
-	User       common.Address
-	Permission Permission
+	AccountID       *big.Int
+	User            common.Address
+	Permission      Permission
+	RawPermission   string
+	Sender          common.Address
+	Granted         bool
+	BlockNumber     uint64
+	BlockTimestamp  uint64
+	TransactionHash string
+	LogIndex        uint
+}
+
+// GetPermissionChangedFromGrantedEvent is used to get PermissionChanged struct from given `PermissionGranted` event
+// and block timestamp. Events with permissions unsupported by the lib are kept with the raw permission value
+func GetPermissionChangedFromGrantedEvent(event *perpsMarket.PerpsMarketPermissionGranted, time uint64) *PermissionChanged {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PermissionChanged").Warning("nil granted event received")
+		return &PermissionChanged{Granted: true}
+	}
+
+	// unsupported permissions are not an error here, the raw value is kept in RawPermission
+	p, _ := PermissionFromBytes(event.Permission)
+
+	return &PermissionChanged{
+		AccountID:       event.AccountId,
+		User:            event.User,
+		Permission:      p,
+		RawPermission:   permissionStringFromBytes(event.Permission),
+		Sender:          event.Sender,
+		Granted:         true,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+		LogIndex:        event.Raw.Index,
+	}
+}
+
+// GetPermissionChangedFromRevokedEvent is used to get PermissionChanged struct from given `PermissionRevoked` event
+// and block timestamp. Events with permissions unsupported by the lib are kept with the raw permission value
+func GetPermissionChangedFromRevokedEvent(event *perpsMarket.PerpsMarketPermissionRevoked, time uint64) *PermissionChanged {
+	if event == nil {
+		logger.Log().WithField("layer", "Models-PermissionChanged").Warning("nil revoked event received")
+		return &PermissionChanged{}
+	}
+
+	// unsupported permissions are not an error here, the raw value is kept in RawPermission
+	p, _ := PermissionFromBytes(event.Permission)
+
+	return &PermissionChanged{
+		AccountID:       event.AccountId,
+		User:            event.User,
+		Permission:      p,
+		RawPermission:   permissionStringFromBytes(event.Permission),
+		Sender:          event.Sender,
+		Granted:         false,
+		BlockNumber:     event.Raw.BlockNumber,
+		BlockTimestamp:  time,
+		TransactionHash: event.Raw.TxHash.Hex(),
+		LogIndex:        event.Raw.Index,
+	}
 }
 
-// getUserPermissions is used to get UserPermissions slice from given contract user permissions slice
-func getUserPermissions(perms []perpsMarket.IAccountModuleAccountPermissions) (res []*UserPermissions) {
+// GetUserPermissions is used to get UserPermissions slice from given contract user permissions slice
+func GetUserPermissions(perms []perpsMarket.IAccountModuleAccountPermissions) (res []*UserPermissions) {
 	for _, p := range perms {
 		perm := &UserPermissions{
 			User:        p.User,
@@ -33,3 +108,23 @@ func getUserPermissions(perms []perpsMarket.IAccountModuleAccountPermissions) (r
 
 	return res
 }
+
+// GetAccountPermissions is used to get AccountPermission slice from given contract user permissions slice
+func GetAccountPermissions(perms []perpsMarket.IAccountModuleAccountPermissions) []*AccountPermission {
+	res := make([]*AccountPermission, 0, len(perms))
+
+	for _, p := range perms {
+		perm := &AccountPermission{
+			User:        p.User,
+			Permissions: make([]string, 0, len(p.Permissions)),
+		}
+
+		for _, b := range p.Permissions {
+			perm.Permissions = append(perm.Permissions, permissionStringFromBytes(b))
+		}
+
+		res = append(res, perm)
+	}
+
+	return res
+}
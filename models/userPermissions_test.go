@@ -1,10 +1,13 @@
 package models
 
 import (
+	"math/big"
+	"testing"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestGetPermissions(t *testing.T) {
@@ -179,9 +182,99 @@ func TestGetPermissions(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			res := getUserPermissions(tt.contractPerms)
+			res := GetUserPermissions(tt.contractPerms)
 
 			require.Equal(t, tt.want, res)
 		})
 	}
 }
+
+func TestGetAccountPermissions(t *testing.T) {
+	var unknown [32]byte
+	copy(unknown[:], "PERPS_NEW_PERMISSION")
+
+	res := GetAccountPermissions([]perpsMarket.IAccountModuleAccountPermissions{
+		{
+			User:        common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+			Permissions: [][32]byte{ADMIN.Bytes(), unknown, PERPS_COMMIT_ASYNC_ORDER.Bytes()},
+		},
+		{
+			User: common.HexToAddress("0x5FF4b3aacdeC86782d8c757FAa638d8790799E83"),
+		},
+	})
+
+	require.Equal(t, []*AccountPermission{
+		{
+			User:        common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+			Permissions: []string{"ADMIN", "PERPS_NEW_PERMISSION", "PERPS_COMMIT_ASYNC_ORDER"},
+		},
+		{
+			User:        common.HexToAddress("0x5FF4b3aacdeC86782d8c757FAa638d8790799E83"),
+			Permissions: []string{},
+		},
+	}, res)
+
+	require.Equal(t, []*AccountPermission{}, GetAccountPermissions(nil))
+}
+
+func TestGetPermissionChangedFromEvents(t *testing.T) {
+	var admin [32]byte
+	copy(admin[:], "ADMIN")
+
+	var unknown [32]byte
+	copy(unknown[:], "UNKNOWN")
+
+	raw := types.Log{
+		BlockNumber: 2,
+		TxHash:      common.BytesToHash([]byte("tx hash")),
+		Index:       3,
+	}
+	want := func(granted bool) *PermissionChanged {
+		return &PermissionChanged{
+			AccountID:       big.NewInt(1),
+			User:            common.BytesToAddress([]byte("user")),
+			Permission:      ADMIN,
+			RawPermission:   "ADMIN",
+			Sender:          common.BytesToAddress([]byte("sender")),
+			Granted:         granted,
+			BlockNumber:     2,
+			BlockTimestamp:  100,
+			TransactionHash: common.BytesToHash([]byte("tx hash")).Hex(),
+			LogIndex:        3,
+		}
+	}
+
+	granted := GetPermissionChangedFromGrantedEvent(&perpsMarket.PerpsMarketPermissionGranted{
+		AccountId:  big.NewInt(1),
+		Permission: admin,
+		User:       common.BytesToAddress([]byte("user")),
+		Sender:     common.BytesToAddress([]byte("sender")),
+		Raw:        raw,
+	}, 100)
+	require.Equal(t, want(true), granted)
+
+	revoked := GetPermissionChangedFromRevokedEvent(&perpsMarket.PerpsMarketPermissionRevoked{
+		AccountId:  big.NewInt(1),
+		Permission: admin,
+		User:       common.BytesToAddress([]byte("user")),
+		Sender:     common.BytesToAddress([]byte("sender")),
+		Raw:        raw,
+	}, 100)
+	require.Equal(t, want(false), revoked)
+
+	unsupported := GetPermissionChangedFromRevokedEvent(&perpsMarket.PerpsMarketPermissionRevoked{
+		AccountId:  big.NewInt(1),
+		Permission: unknown,
+		User:       common.BytesToAddress([]byte("user")),
+		Sender:     common.BytesToAddress([]byte("sender")),
+		Raw:        raw,
+	}, 100)
+	require.False(t, unsupported.Permission.IsSupported())
+	require.Equal(t, "", unsupported.Permission.String())
+	require.Equal(t, "UNKNOWN", unsupported.RawPermission)
+	require.Equal(t, big.NewInt(1), unsupported.AccountID)
+	require.False(t, unsupported.Granted)
+
+	nilGranted := GetPermissionChangedFromGrantedEvent(nil, 100)
+	require.Equal(t, &PermissionChanged{Granted: true}, nilGranted)
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VaultCollateral is a core vault collateral data struct
+//   - PoolID: ID of the pool the vault belongs to.
+//   - CollateralType: Address of the vault collateral token.
+//   - Amount: Amount of the collateral delegated to the vault.
+//   - Value: USD value of the delegated collateral.
+type VaultCollateral struct {
+	PoolID         *big.Int
+	CollateralType common.Address
+	Amount         *big.Int
+	Value          *big.Int
+}
+
+// PositionCollateral is a core delegated position collateral data struct
+//   - AccountID: ID of the core account owning the position.
+//   - PoolID: ID of the pool the collateral is delegated to.
+//   - CollateralType: Address of the position collateral token.
+//   - Amount: Amount of the collateral delegated by the position.
+//   - Value: USD value of the delegated collateral.
+type PositionCollateral struct {
+	AccountID      *big.Int
+	PoolID         *big.Int
+	CollateralType common.Address
+	Amount         *big.Int
+	Value          *big.Int
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gateway-fm/perpsv3-Go/config"
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
 	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/events"
 	"github.com/gateway-fm/perpsv3-Go/models"
@@ -72,30 +73,65 @@ type IPerpsv3 interface {
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveAccountLiquidationsLimit(limit uint64) ([]*models.AccountLiquidated, error)
 
+	// RetrieveUSDMinted is used to get all `UsdMinted` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveUSDMinted(fromBlock uint64, toBLock *uint64) ([]*models.USDMinted, error)
+
 	// RetrieveUSDMintedLimit is used to get all `usdMinted` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, error)
 
+	// RetrieveUSDBurned is used to get all `UsdBurned` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveUSDBurned(fromBlock uint64, toBLock *uint64) ([]*models.USDBurned, error)
+
 	// RetrieveUSDBurnedLimit is used to get all `usdBurned` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, error)
 
+	// RetrieveDelegationUpdated is used to get all `DelegationUpdated` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveDelegationUpdated(fromBlock uint64, toBLock *uint64) ([]*models.DelegationUpdated, error)
+
 	// RetrieveDelegationUpdatedLimit is used to get all `DelegationUpdated` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error)
 
+	// RetrieveCollateralWithdrawn is used to get all `Withdrawn` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCollateralWithdrawn(fromBlock uint64, toBLock *uint64) ([]*models.CollateralWithdrawn, error)
+
 	// RetrieveCollateralWithdrawnLimit is used to get all `Withdrawn` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error)
 
+	// RetrieveCollateralDeposited is used to get all `Deposited` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCollateralDeposited(fromBlock uint64, toBLock *uint64) ([]*models.CollateralDeposited, error)
+
 	// RetrieveCollateralDepositedLimit is used to get all `Deposited` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error)
 
+	// RetrieveRewardClaimed is used to get all `RewardsClaimed` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveRewardClaimed(fromBlock uint64, toBLock *uint64) ([]*models.RewardClaimed, error)
+
 	// RetrieveRewardClaimedLimit is used to get all `RewardClaimed` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveRewardClaimedLimit(limit uint64) ([]*models.RewardClaimed, error)
 
+	// RetrieveRewardDistributed is used to get all `RewardsDistributed` events from the Core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveRewardDistributed(fromBlock uint64, toBLock *uint64) ([]*models.RewardDistributed, error)
+
 	// RetrieveRewardDistributedLimit is used to get all `RewardDistributed` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveRewardDistributedLimit(limit uint64) ([]*models.RewardDistributed, error)
@@ -108,6 +144,472 @@ type IPerpsv3 interface {
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveMarketUSDWithdrawnLimit(limit uint64) ([]*models.MarketUSDWithdrawn, error)
 
+	// RetrieveOrdersCanceled is used to get logs from the "OrderCancelled" event perps market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveOrdersCanceled(fromBlock uint64, toBLock *uint64) ([]*models.OrderCanceled, error)
+
+	// RetrieveOrdersCanceledLimit is used to get all "OrderCancelled" events and their additional data from the contract
+	// with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveOrdersCanceledLimit(limit uint64) ([]*models.OrderCanceled, error)
+
+	// RetrievePreviousOrderExpired is used to get logs from the "PreviousOrderExpired" event perps market contract within
+	// given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePreviousOrderExpired(fromBlock uint64, toBLock *uint64) ([]*models.OrderExpired, error)
+
+	// RetrievePreviousOrderExpiredLimit is used to get all "PreviousOrderExpired" events and their additional data from the
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrievePreviousOrderExpiredLimit(limit uint64) ([]*models.OrderExpired, error)
+
+	// RetrieveCollateralModified is used to get logs from the "CollateralModified" event perps market contract within
+	// given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCollateralModified(fromBlock uint64, toBLock *uint64) ([]*models.CollateralModified, error)
+
+	// RetrieveCollateralModifiedLimit is used to get all "CollateralModified" events and their additional data from the
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveCollateralModifiedLimit(limit uint64) ([]*models.CollateralModified, error)
+
+	// RetrieveAccountsCreated is used to get logs from the "AccountCreated" event perps market contract within given
+	// block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.AccountCreated, error)
+
+	// RetrieveAccountsCreatedLimit is used to get all "AccountCreated" events and their additional data from the contract
+	// with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveAccountsCreatedLimit(limit uint64) ([]*models.AccountCreated, error)
+
+	// RetrieveAccountLiquidationAttempts is used to get logs from the "AccountLiquidationAttempt" event perps market
+	// contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveAccountLiquidationAttempts(fromBlock uint64, toBLock *uint64) ([]*models.AccountLiquidationAttempt, error)
+
+	// RetrieveAccountLiquidationAttemptsLimit is used to get all "AccountLiquidationAttempt" events and their additional
+	// data from the contract with given block search limit. If given limit is 0 function will set default value to
+	// 20 000 blocks
+	RetrieveAccountLiquidationAttemptsLimit(limit uint64) ([]*models.AccountLiquidationAttempt, error)
+
+	// RetrieveSettlementStrategiesAdded is used to get logs from the "SettlementStrategyAdded" event perps market
+	// contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSettlementStrategiesAdded(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyAdded, error)
+
+	// RetrieveSettlementStrategiesAddedLimit is used to get all "SettlementStrategyAdded" events and their additional
+	// data from the contract with given block search limit. If given limit is 0 function will set default value to
+	// 20 000 blocks
+	RetrieveSettlementStrategiesAddedLimit(limit uint64) ([]*models.SettlementStrategyAdded, error)
+
+	// RetrieveSettlementStrategyUpdates is used to get logs from the "SettlementStrategySet" event perps market
+	// contract within given block range. Result is ordered by block number
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSettlementStrategyUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyUpdate, error)
+
+	// RetrieveSettlementStrategyUpdatesLimit is used to get all "SettlementStrategySet" events and their additional
+	// data from the contract with given block search limit. Result is ordered by block number. If given limit is 0
+	// function will set default value to 20 000 blocks
+	RetrieveSettlementStrategyUpdatesLimit(limit uint64) ([]*models.SettlementStrategyUpdate, error)
+
+	// RetrieveFundingParametersSet is used to get logs from the "FundingParametersSet" event perps market contract
+	// within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveFundingParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.FundingParameters, error)
+
+	// RetrieveFundingParametersSetLimit is used to get all "FundingParametersSet" events and their additional data from
+	// the contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveFundingParametersSetLimit(limit uint64) ([]*models.FundingParameters, error)
+
+	// RetrieveMarketsCreated is used to get logs from the "MarketCreated" event perps market contract within given
+	// block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMarketsCreated(fromBlock uint64, toBLock *uint64) ([]*models.MarketCreated, error)
+
+	// RetrieveMarketsCreatedLimit is used to get all "MarketCreated" events and their additional data from the contract
+	// with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveMarketsCreatedLimit(limit uint64) ([]*models.MarketCreated, error)
+
+	// RetrieveLiquidationParametersSet is used to get logs from the "LiquidationParametersSet" event perps market
+	// contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.LiquidationParameters, error)
+
+	// RetrieveLiquidationParametersSetLimit is used to get all "LiquidationParametersSet" events and their additional
+	// data from the contract with given block search limit. If given limit is 0 function will set default value to
+	// 20 000 blocks
+	RetrieveLiquidationParametersSetLimit(limit uint64) ([]*models.LiquidationParameters, error)
+
+	// RetrieveMaxLiquidationParametersSet is used to get logs from the "MaxLiquidationParametersSet" event perps market
+	// contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMaxLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxLiquidationParameters, error)
+
+	// RetrieveMaxLiquidationParametersSetLimit is used to get all "MaxLiquidationParametersSet" events and their
+	// additional data from the contract with given block search limit. If given limit is 0 function will set default
+	// value to 20 000 blocks
+	RetrieveMaxLiquidationParametersSetLimit(limit uint64) ([]*models.MaxLiquidationParameters, error)
+
+	// RetrieveMaxMarketSizesSet is used to get logs from the "MaxMarketSizeSet" event perps market contract within
+	// given block range. Result is ordered by block number
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMaxMarketSizesSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxMarketSize, error)
+
+	// RetrieveMaxMarketSizesSetLimit is used to get all "MaxMarketSizeSet" events and their additional data from the
+	// contract with given block search limit. Result is ordered by block number. If given limit is 0 function will set
+	// default value to 20 000 blocks
+	RetrieveMaxMarketSizesSetLimit(limit uint64) ([]*models.MaxMarketSize, error)
+
+	// RetrieveOrderFeesSet is used to get logs from the "OrderFeesSet" event perps market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveOrderFeesSet(fromBlock uint64, toBLock *uint64) ([]*models.OrderFees, error)
+
+	// RetrieveOrderFeesSetLimit is used to get all "OrderFeesSet" events and their additional data from the contract
+	// with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveOrderFeesSetLimit(limit uint64) ([]*models.OrderFees, error)
+
+	// RetrieveMarketPriceDataUpdated is used to get logs from the "MarketPriceDataUpdated" event perps market contract
+	// within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMarketPriceDataUpdated(fromBlock uint64, toBLock *uint64) ([]*models.MarketPriceData, error)
+
+	// RetrieveMarketPriceDataUpdatedLimit is used to get all "MarketPriceDataUpdated" events and their additional data
+	// from the contract with given block search limit. Returns empty slice if there are no updates. If given limit is 0
+	// function will set default value to 20 000 blocks
+	RetrieveMarketPriceDataUpdatedLimit(limit uint64) ([]*models.MarketPriceData, error)
+
+	// RetrieveKeeperRewardGuardsSet is used to get logs from the "KeeperRewardGuardsSet" event perps market contract
+	// within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveKeeperRewardGuardsSet(fromBlock uint64, toBLock *uint64) ([]*models.KeeperRewardGuards, error)
+
+	// RetrieveKeeperRewardGuardsSetLimit is used to get all "KeeperRewardGuardsSet" events and their additional data
+	// from the contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveKeeperRewardGuardsSetLimit(limit uint64) ([]*models.KeeperRewardGuards, error)
+
+	// RetrievePerAccountCapsSet is used to get logs from the "PerAccountCapsSet" event perps market contract within
+	// given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePerAccountCapsSet(fromBlock uint64, toBLock *uint64) ([]*models.PerAccountCaps, error)
+
+	// RetrievePerAccountCapsSetLimit is used to get all "PerAccountCapsSet" events and their additional data from the
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrievePerAccountCapsSetLimit(limit uint64) ([]*models.PerAccountCaps, error)
+
+	// RetrievePerpsCollateralConfigured is used to get logs from the "CollateralConfigurationSet" event perps market
+	// contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePerpsCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.PerpsCollateralConfig, error)
+
+	// RetrievePerpsCollateralConfiguredLimit is used to get all "CollateralConfigurationSet" events and their additional
+	// data from the perps market contract with given block search limit. If given limit is 0 function will set default
+	// value to 20 000 blocks
+	RetrievePerpsCollateralConfiguredLimit(limit uint64) ([]*models.PerpsCollateralConfig, error)
+
+	// RetrieveFeeCollectorSet is used to get logs from the "FeeCollectorSet" event perps market contract within given
+	// block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveFeeCollectorSet(fromBlock uint64, toBLock *uint64) ([]*models.FeeCollector, error)
+
+	// RetrieveFeeCollectorSetLimit is used to get all "FeeCollectorSet" events and their additional data from the
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveFeeCollectorSetLimit(limit uint64) ([]*models.FeeCollector, error)
+
+	// RetrieveReferrerSharesUpdated is used to get logs from the "ReferrerShareUpdated" event perps market contract
+	// within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveReferrerSharesUpdated(fromBlock uint64, toBLock *uint64) ([]*models.ReferrerShare, error)
+
+	// RetrieveReferrerSharesUpdatedLimit is used to get all "ReferrerShareUpdated" events and their additional data
+	// from the contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveReferrerSharesUpdatedLimit(limit uint64) ([]*models.ReferrerShare, error)
+
+	// RetrieveSynthsBought is used to get logs from the "SynthBought" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSynthsBought(fromBlock uint64, toBLock *uint64) ([]*models.SynthBought, error)
+
+	// RetrieveSynthsBoughtLimit is used to get all "SynthBought" events and their additional data from the spot market
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveSynthsBoughtLimit(limit uint64) ([]*models.SynthBought, error)
+
+	// RetrieveSynthsSold is used to get logs from the "SynthSold" event spot market contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSynthsSold(fromBlock uint64, toBLock *uint64) ([]*models.SynthSold, error)
+
+	// RetrieveSynthsSoldLimit is used to get all "SynthSold" events and their additional data from the spot market
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveSynthsSoldLimit(limit uint64) ([]*models.SynthSold, error)
+
+	// RetrieveSynthsWrapped is used to get logs from the "SynthWrapped" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSynthsWrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthWrapped, error)
+
+	// RetrieveSynthsWrappedLimit is used to get all "SynthWrapped" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveSynthsWrappedLimit(limit uint64) ([]*models.SynthWrapped, error)
+
+	// RetrieveSynthsUnwrapped is used to get logs from the "SynthUnwrapped" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSynthsUnwrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthUnwrapped, error)
+
+	// RetrieveSynthsUnwrappedLimit is used to get all "SynthUnwrapped" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveSynthsUnwrappedLimit(limit uint64) ([]*models.SynthUnwrapped, error)
+
+	// RetrieveSpotOrdersCommitted is used to get logs from the "OrderCommitted" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSpotOrdersCommitted(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCommitted, error)
+
+	// RetrieveSpotOrdersCommittedLimit is used to get all "OrderCommitted" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveSpotOrdersCommittedLimit(limit uint64) ([]*models.SpotOrderCommitted, error)
+
+	// RetrieveSpotOrdersSettled is used to get logs from the "OrderSettled" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSpotOrdersSettled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderSettled, error)
+
+	// RetrieveSpotOrdersSettledLimit is used to get all "OrderSettled" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveSpotOrdersSettledLimit(limit uint64) ([]*models.SpotOrderSettled, error)
+
+	// RetrieveSpotOrdersCancelled is used to get logs from the "OrderCancelled" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSpotOrdersCancelled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCancelled, error)
+
+	// RetrieveSpotOrdersCancelledLimit is used to get all "OrderCancelled" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveSpotOrdersCancelledLimit(limit uint64) ([]*models.SpotOrderCancelled, error)
+
+	// RetrieveSynthsRegistered is used to get logs from the "SynthRegistered" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSynthsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.SynthRegistered, error)
+
+	// RetrieveSynthsRegisteredLimit is used to get all "SynthRegistered" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveSynthsRegisteredLimit(limit uint64) ([]*models.SynthRegistered, error)
+
+	// RetrieveWrappersSet is used to get logs from the "WrapperSet" event spot market contract within given block
+	// range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveWrappersSet(fromBlock uint64, toBLock *uint64) ([]*models.WrapperSet, error)
+
+	// RetrieveWrappersSetLimit is used to get all "WrapperSet" events and their additional data from the spot
+	// market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveWrappersSetLimit(limit uint64) ([]*models.WrapperSet, error)
+
+	// RetrieveSpotMarketFeeUpdates is used to get logs from the "AtomicFixedFeeSet", "AsyncFixedFeeSet",
+	// "MarketUtilizationFeesSet" and "MarketSkewScaleSet" events spot market contract within given block range. Events are
+	// normalized to models.SpotFeeUpdate and sorted by block number
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveSpotMarketFeeUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SpotFeeUpdate, error)
+
+	// RetrieveSpotMarketFeeUpdatesLimit is used to get all spot market fee configuration events sorted by block number
+	// from the spot market contract with given block search limit. If given limit is 0 function will set default value to
+	// 20 000 blocks
+	RetrieveSpotMarketFeeUpdatesLimit(limit uint64) ([]*models.SpotFeeUpdate, error)
+
+	// GetSynthAddress is used to get checksummed ERC-20 token address of the synth for given synth market ID. Returns
+	// NotFoundErr if no synth is registered for given market ID
+	GetSynthAddress(synthMarketID *big.Int) (string, error)
+
+	// GetSpotMarketFees is used to get current fee configuration of given synth market ID from the spot market contract.
+	// Returned struct holds the same values as the RetrieveSpotMarketFeeUpdates events plus wrap and unwrap fees
+	GetSpotMarketFees(synthMarketID *big.Int) (*models.SpotMarketFees, error)
+
+	// GetSpotSettlementStrategy is used to get spot market async order settlement strategy parameters by given synth
+	// market ID and strategy ID. Use SpotSettlementStrategy.FeedIDHex to get the price feed ID as a hex string
+	GetSpotSettlementStrategy(synthMarketID *big.Int, strategyID *big.Int) (*models.SpotSettlementStrategy, error)
+
+	// GetQuoteBuyExactIn is used to get synth amount and fees breakdown of buying synth of given synth market ID for
+	// exactly given USD amount. Returns OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetQuoteBuyExactIn(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error)
+
+	// GetQuoteBuyExactOut is used to get USD amount charged and fees breakdown of buying exactly given synth amount of
+	// given synth market ID. Returns OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetQuoteBuyExactOut(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error)
+
+	// GetQuoteSellExactIn is used to get USD amount returned and fees breakdown of selling exactly given synth amount of
+	// given synth market ID. Returns OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetQuoteSellExactIn(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error)
+
+	// GetQuoteSellExactOut is used to get synth amount to burn and fees breakdown of selling synth of given synth market
+	// ID for exactly given USD amount. Returns OracleDataRequiredErr if the call reverted with ERC7412
+	// `OracleDataRequired` error
+	GetQuoteSellExactOut(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error)
+
+	// RetrieveCoreAccountsCreated is used to get logs from the "AccountCreated" event core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCoreAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.CoreAccountCreated, error)
+
+	// RetrieveCoreAccountsCreatedLimit is used to get all "AccountCreated" events and their additional data from the core
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveCoreAccountsCreatedLimit(limit uint64) ([]*models.CoreAccountCreated, error)
+
+	// RetrievePoolsCreated is used to get logs from the "PoolCreated" event core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePoolsCreated(fromBlock uint64, toBLock *uint64) ([]*models.PoolCreated, error)
+
+	// RetrievePoolsCreatedLimit is used to get all "PoolCreated" events and their additional data from the core contract
+	// with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrievePoolsCreatedLimit(limit uint64) ([]*models.PoolCreated, error)
+
+	// RetrievePoolConfigurationsSet is used to get logs from the "PoolConfigurationSet" event core contract within given
+	// block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePoolConfigurationsSet(fromBlock uint64, toBLock *uint64) ([]*models.PoolConfigurationSet, error)
+
+	// RetrievePoolConfigurationsSetLimit is used to get all "PoolConfigurationSet" events and their additional data from the
+	// core contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrievePoolConfigurationsSetLimit(limit uint64) ([]*models.PoolConfigurationSet, error)
+
+	// RetrieveMarketsRegistered is used to get logs from the "MarketRegistered" event core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMarketsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.MarketRegistered, error)
+
+	// RetrieveMarketsRegisteredLimit is used to get all "MarketRegistered" events and their additional data from the core
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveMarketsRegisteredLimit(limit uint64) ([]*models.MarketRegistered, error)
+
+	// RetrieveMarketCollateralChanges is used to get logs from the "MarketCollateralDeposited" and
+	// "MarketCollateralWithdrawn" events core contract within given block range merged to one stream sorted by block
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMarketCollateralChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketCollateralChange, error)
+
+	// RetrieveMarketCollateralChangesLimit is used to get all market collateral deposits and withdrawals sorted by block
+	// from the core contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveMarketCollateralChangesLimit(limit uint64) ([]*models.MarketCollateralChange, error)
+
+	// RetrieveMarketUSDChanges is used to get logs from the "MarketUsdDeposited" and
+	// "MarketUsdWithdrawn" events core contract within given block range merged to one stream sorted by block
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveMarketUSDChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketUSDChange, error)
+
+	// RetrieveMarketUSDChangesLimit is used to get all market USD deposits and withdrawals sorted by block
+	// from the core contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrieveMarketUSDChangesLimit(limit uint64) ([]*models.MarketUSDChange, error)
+
+	// RetrieveCoreLiquidations is used to get logs from the "Liquidation" event core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCoreLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.CoreLiquidation, error)
+
+	// RetrieveCoreLiquidationsLimit is used to get all "Liquidation" events and their additional data from the core contract
+	// with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveCoreLiquidationsLimit(limit uint64) ([]*models.CoreLiquidation, error)
+
+	// RetrieveVaultLiquidations is used to get logs from the "VaultLiquidation" event core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveVaultLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.VaultLiquidation, error)
+
+	// RetrieveVaultLiquidationsLimit is used to get all "VaultLiquidation" events and their additional data from the core
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveVaultLiquidationsLimit(limit uint64) ([]*models.VaultLiquidation, error)
+
+	// RetrievePermissionChanges is used to get logs from the "PermissionGranted" and "PermissionRevoked" events perps
+	// market contract within given block range merged to one stream sorted by block
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePermissionChanges(fromBlock uint64, toBLock *uint64) ([]*models.PermissionChanged, error)
+
+	// RetrievePermissionChangesLimit is used to get all granted and revoked account permissions sorted by block from the
+	// perps market contract with given block search limit. If given limit is 0 function will set default value to 20 000
+	// blocks
+	RetrievePermissionChangesLimit(limit uint64) ([]*models.PermissionChanged, error)
+
+	// RetrieveCollateralConfigured is used to get logs from the "CollateralConfigured" event core contract within given
+	// block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.CollateralConfiguration, error)
+
+	// RetrieveCollateralConfiguredLimit is used to get all "CollateralConfigured" events and their additional data from the
+	// core contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveCollateralConfiguredLimit(limit uint64) ([]*models.CollateralConfiguration, error)
+
+	// RetrieveCollateralLockChanges is used to get logs from the "CollateralLockCreated" and "CollateralLockExpired"
+	// events core contract within given block range merged to one stream sorted by block and log index
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrieveCollateralLockChanges(fromBlock uint64, toBLock *uint64) ([]*models.CollateralLock, error)
+
+	// RetrieveCollateralLockChangesLimit is used to get all created and expired collateral locks sorted by block from the
+	// core contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrieveCollateralLockChangesLimit(limit uint64) ([]*models.CollateralLock, error)
+
+	// RetrievePoolOwnershipChanges is used to get logs from the "PoolOwnerNominated", "PoolOwnershipAccepted",
+	// "PoolNominationRevoked" and "PoolNominationRenounced" events core contract within given block range merged to one
+	// stream sorted by block and log index
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePoolOwnershipChanges(fromBlock uint64, toBLock *uint64) ([]*models.PoolOwnershipChange, error)
+
+	// RetrievePoolOwnershipChangesLimit is used to get all pool ownership nominations, acceptances, revocations and
+	// renouncements sorted by block from the core contract with given block search limit. If given limit is 0 function
+	// will set default value to 20 000 blocks
+	RetrievePoolOwnershipChangesLimit(limit uint64) ([]*models.PoolOwnershipChange, error)
+
+	// RetrievePoolNameUpdates is used to get logs from the "PoolNameUpdated" event core contract within given block range
+	//   - use 0 for fromBlock to use default value of a first contract block
+	//   - use nil for toBlock to use default value of a last blockchain block
+	RetrievePoolNameUpdates(fromBlock uint64, toBLock *uint64) ([]*models.PoolNameUpdated, error)
+
+	// RetrievePoolNameUpdatesLimit is used to get all "PoolNameUpdated" events and their additional data from the core
+	// contract with given block search limit. If given limit is 0 function will set default value to 20 000 blocks
+	RetrievePoolNameUpdatesLimit(limit uint64) ([]*models.PoolNameUpdated, error)
+
 	// ListenTrades is used to subscribe on the contract "OrderSettled" event. The goroutine will return events on the
 	// TradesChan chanel and errors on the ErrChan chanel.
 	// To close the subscription use events.TradeSubscription `Close` function
@@ -165,205 +667,820 @@ type IPerpsv3 interface {
 	// struct and return errors on ErrChan chanel
 	ListenCollateralWithdrawn() (*events.CollateralWithdrawnSubscription, error)
 
-	// ListenCollateralDeposited is used to listen to all 'Deposited' Core contract events and return them as models.CollateralDeposited
-	// struct and return errors on ErrChan chanel
-	ListenCollateralDeposited() (*events.CollateralDepositedSubscription, error)
+	// ListenCollateralDeposited is used to listen to all 'Deposited' Core contract events and return them as models.CollateralDeposited
+	// struct and return errors on ErrChan chanel
+	ListenCollateralDeposited() (*events.CollateralDepositedSubscription, error)
+
+	// ListenRewardDistributed is used to listen to all 'RewardDistributed' Core contract events and return them as models.RewardDistributed
+	// struct and return errors on ErrChan chanel
+	ListenRewardDistributed() (*events.RewardDistributedSubscription, error)
+
+	// ListenRewardClaimed is used to listen to all 'RewardClaimed' Core contract events and return them as models.RewardClaimed
+	// struct and return errors on ErrChan chanel
+	ListenRewardClaimed() (*events.RewardClaimedSubscription, error)
+
+	// ListenMarketUSDWithdrawn is used to listen to all 'MarketUSDWithdrawn' Core contract events and return them as models.MarketUSDWithdrawn
+	// struct and return errors on ErrChan chanel
+	ListenMarketUSDWithdrawn() (*events.MarketUSDWithdrawnSubscription, error)
+
+	// ListenMarketUSDDeposited is used to listen to all 'MarketUSDDeposited' Core contract events and return them as models.MarketUSDDeposited
+	// struct and return errors on ErrChan chanel
+	ListenMarketUSDDeposited() (*events.MarketUSDDepositedSubscription, error)
+
+	// GetPosition is used to get position data struct from latest block with given params
+	// Function can return contract error if market ID is invalid
+	GetPosition(accountID *big.Int, marketID *big.Int) (*models.Position, error)
+
+	// GetAccountOpenPositions is used to get all non-zero open positions of given account ID from the latest block with
+	// position market ID included. Positions are fetched concurrently and are not guaranteed to come from the same block
+	GetAccountOpenPositions(accountID *big.Int) ([]*models.Position, error)
+
+	// GetMarketMetadata is used to get market metadata by given market ID. Given market id cannot be nil and should exist
+	// in the smart contract
+	GetMarketMetadata(marketID *big.Int) (*models.MarketMetadata, error)
+
+	// GetMarketSummary is used to get market summary by given market ID. Given market id cannot be nil
+	GetMarketSummary(marketID *big.Int) (*models.MarketSummary, error)
+
+	// GetMarketIDs is used to get market IDs from the smart contract
+	GetMarketIDs() ([]*big.Int, error)
+
+	// GetMarkets is used to get metadata of all perps markets. Markets with failed metadata request are skipped
+	GetMarkets() ([]*models.MarketMetadata, error)
+
+	// GetFoundingRate is used to get current market founding rate by given market ID
+	//
+	// Deprecated: use GetFundingRate instead
+	GetFoundingRate(marketId *big.Int) (*big.Int, error)
+
+	// GetFundingRate is used to get current market funding rate by given market ID from the latest block. Returned value
+	// is a signed 18 decimals number. Returns InvalidArgumentErr if market does not exist. Like GetIndexPrice, the rate
+	// is read with the forwarder multicall on Base networks with trusted forwarder
+	GetFundingRate(marketID *big.Int) (*big.Int, error)
+
+	// GetFundingVelocity is used to get current market funding velocity by given market ID from the latest block.
+	// Returns InvalidArgumentErr if market does not exist
+	GetFundingVelocity(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketSize is used to get current market size (open interest) by given market ID from the latest block.
+	// Returned value is an 18 decimals number. The size is taken from the market summary with the same call path as
+	// GetMarketSummary. Returns InvalidArgumentErr if market does not exist
+	GetMarketSize(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketSkew is used to get current market skew by given market ID from the latest block. Returned value is a
+	// signed 18 decimals number, negative for short skewed markets. Returns InvalidArgumentErr if market does not exist
+	GetMarketSkew(marketID *big.Int) (*big.Int, error)
+
+	// GetMaxOpenInterest is used to get max open interest by given market ID from the latest block. Returned value is an
+	// 18 decimals number. Returns InvalidArgumentErr if market does not exist
+	GetMaxOpenInterest(marketID *big.Int) (*big.Int, error)
+
+	// GetMaxMarketSize is used to get max market size (open interest cap in market units) by given market ID from the
+	// latest block. Returns InvalidArgumentErr if market does not exist
+	GetMaxMarketSize(marketID *big.Int) (*big.Int, error)
+
+	// GetIndexPrice is used to get perps market oracle index price by given market ID from the latest block. On Base
+	// networks with trusted forwarder the price is read with the forwarder multicall, which fulfills the Pyth oracle
+	// query on Base mainnet. Other networks return OracleDataRequiredErr if the call reverted with ERC7412
+	// `OracleDataRequired` error and InvalidArgumentErr if market does not exist
+	GetIndexPrice(marketID *big.Int) (*big.Int, error)
+
+	// GetFillPrice is used to get expected fill price for the order with given signed size delta (positive for long and
+	// negative for short orders) in given market using current index price. Index price and fill price are read at the
+	// same latest block, except multicall based networks which always call the latest block. The fill price is the
+	// average of the index price adjusted by the premium/discount before and after the order, so:
+	//   - zero sizeDelta returns the index price adjusted by the current market skew premium/discount
+	//   - sizeDelta large enough to cross the skew flips the premium sign for the part of the order past the zero skew,
+	//     so the fill price moves from the premium to the discount side of the index price (or backwards)
+	GetFillPrice(marketID *big.Int, sizeDelta *big.Int) (*big.Int, error)
+
+	// GetAvailableMargin is used to get available margin for given account ID. Returned value is a signed 18 decimals
+	// number and is negative for underwater accounts
+	GetAvailableMargin(accountId *big.Int) (*big.Int, error)
+
+	// GetWithdrawableMargin is used to get margin available for withdrawal for given account ID with open positions
+	// requirements taken into account. Returns AccountNotFoundErr if perps account does not exist
+	GetWithdrawableMargin(accountId *big.Int) (*big.Int, error)
+
+	// GetRequiredMarginForOrder is used to get 18 decimals margin required by the contract to commit an order with given
+	// signed size delta in given market for given account ID. Comparing it with GetAvailableMargin allows to reject
+	// orders which would revert with InsufficientMargin. Returns AccountNotFoundErr if perps account does not exist
+	GetRequiredMarginForOrder(accountId *big.Int, marketId *big.Int, sizeDelta *big.Int) (*big.Int, error)
+
+	// GetLiquidationParameters is used to get liquidation params for given market ID from the latest block. Returned
+	// struct is the same one used by RetrieveLiquidationParametersSet so both sources can be mixed
+	GetLiquidationParameters(marketId *big.Int) (*models.LiquidationParameters, error)
+
+	// GetFundingParameters is used to get funding params for given market ID from the latest block. Returns
+	// InvalidArgumentErr if market does not exist
+	GetFundingParameters(marketId *big.Int) (*models.FundingParameters, error)
+
+	// GetOrderFees is used to get maker and taker fee ratios for given market ID from the latest block. Returned struct is
+	// the same one used by RetrieveOrderFeesSet so both sources can be mixed
+	GetOrderFees(marketId *big.Int) (*models.OrderFees, error)
+
+	// ComputeOrderFees is used to get fees and fill price the contract will use for the order with given signed size delta
+	// in given market at the latest block. Orders that flip the skew are charged with maker fee for the part reducing the
+	// skew and with taker fee for the rest
+	ComputeOrderFees(marketId *big.Int, sizeDelta *big.Int) (*models.OrderFeeQuote, error)
+
+	// GetKeeperRewardGuards is used to get current keeper reward guards from the latest block. Returned struct is the same
+	// one used by RetrieveKeeperRewardGuardsSet so both sources can be mixed
+	GetKeeperRewardGuards() (*models.KeeperRewardGuards, error)
+
+	// GetAccountLastInteraction is used to get accounts last interaction unix timestamp for given account ID. Returns 0
+	// for accounts without any interaction
+	GetAccountLastInteraction(accountId *big.Int) (uint64, error)
+
+	// GetAccountOwner is used to get accounts owner checksummed address for given account ID. Returns
+	// AccountNotFoundErr if perps account does not exist or was burned
+	GetAccountOwner(accountId *big.Int) (string, error)
+
+	// GetAccountPermissions is used to get all users holding permissions on given account ID with their decoded
+	// permission strings (e.g. "ADMIN", "PERPS_COMMIT_ASYNC_ORDER"). Permissions unknown to the models.Permission enum are
+	// kept as decoded raw strings
+	GetAccountPermissions(accountId *big.Int) ([]*models.AccountPermission, error)
+
+	// HasPermission is used to check if given user address was explicitly granted given permission (e.g. "ADMIN",
+	// "PERPS_COMMIT_ASYNC_ORDER") on given account ID. Account owner is not reported unless granted the permission
+	HasPermission(accountId *big.Int, user string, permission string) (bool, error)
+
+	// GetCollateralAmount is used to get accounts collateral amount for given synth market ID. Use 0 as market ID for the
+	// sUSD collateral
+	GetCollateralAmount(accountId *big.Int, marketId *big.Int) (*big.Int, error)
+
+	// GetAccountCollateralIds is used to get synth market IDs of all collaterals deposited by given account ID
+	GetAccountCollateralIds(accountId *big.Int) ([]*big.Int, error)
+
+	// GetTotalCollateralValue is used to get total value of all collaterals deposited by given account ID. Returns
+	// OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetTotalCollateralValue(accountId *big.Int) (*big.Int, error)
+
+	// GetAccountCollaterals is used to get synth market ID and deposited amount of each collateral of given account ID
+	GetAccountCollaterals(accountId *big.Int) ([]*models.AccountCollateral, error)
+
+	// GetSupportedCollaterals is used to get configuration of each synth supported as perps margin from the latest block.
+	// Collaterals with zero max amount are returned with Disabled flag set
+	GetSupportedCollaterals() ([]*models.PerpsCollateralConfig, error)
+
+	// GetPendingOrder is used to get pending async order of given account ID. Nil is returned without error if account
+	// has no pending order
+	GetPendingOrder(accountId *big.Int) (*models.PendingOrder, error)
+
+	// GetSettlementStrategy is used to get settlement strategy parameters by given market ID and strategy ID. Use
+	// SettlementStrategy.FeedIDHex to get the price feed ID as a hex string
+	GetSettlementStrategy(marketId *big.Int, strategyId *big.Int) (*models.SettlementStrategy, error)
+
+	// GetRequiredMaintenanceMargin is used to get required maintenance margin for given account ID
+	GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error)
+
+	// GetRequiredMargins is used to get required initial margin, required maintenance margin and max liquidation reward
+	// for given account ID from single contract call
+	GetRequiredMargins(accountId *big.Int) (*models.RequiredMargins, error)
+
+	// CanLiquidate is used to check if given account ID can be liquidated at the latest block. On Base networks with
+	// trusted forwarder the check is made with the forwarder multicall, which fulfills Pyth oracle queries for all perps
+	// markets on Base mainnet
+	CanLiquidate(accountId *big.Int) (bool, error)
+
+	// CanLiquidateAccounts is used to check if given account IDs can be liquidated at the latest block using concurrent
+	// contract calls. Results keep given IDs order. Failed checks do not stop the batch: each result holds its own
+	// account error, and BatchErr wrapping every per-account error is returned together with all results
+	CanLiquidateAccounts(accountIds []*big.Int) ([]*models.LiquidationCheck, error)
+
+	// GetCollateralPrice is used to get collateral price for given block number and collateralType
+	GetCollateralPrice(blockNumber *big.Int, collateralType common.Address) (*models.CollateralPrice, error)
+
+	// GetVaultDebt is used to get vault debt for given pool ID and collateralType. Returned value is a signed 18 decimals
+	// number and is negative when the vault is in credit
+	GetVaultDebt(poolID *big.Int, collateralType common.Address) (*big.Int, error)
+
+	// GetMarketReportedDebt is used to get debt reported by the market with given ID to the core. Returns OracleDataRequiredErr
+	// if market price feeds are stale
+	GetMarketReportedDebt(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketTotalDebt is used to get total debt of the market with given ID. Result is a signed 18 decimals number and is
+	// negative when the market is in credit
+	GetMarketTotalDebt(marketID *big.Int) (*big.Int, error)
+
+	// GetWithdrawableMarketUsd is used to get amount of snxUSD the market with given ID can currently withdraw from the core
+	GetWithdrawableMarketUsd(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketDebtSummary is used to get reported debt, total debt and withdrawable snxUSD of the market with given ID
+	GetMarketDebtSummary(marketID *big.Int) (*models.MarketDebtSummary, error)
+
+	// GetAccountAvailableCollateral is used to get amount of given collateralType deposited to the core by the account
+	// which is neither delegated nor locked and can be withdrawn. Never deposited collateral types return 0
+	GetAccountAvailableCollateral(accountID *big.Int, collateralType common.Address) (*big.Int, error)
+
+	// GetMarketNetIssuance is used to get net snxUSD issuance of the market with given ID. Result is a signed 18 decimals
+	// number and is negative when the market deposited more snxUSD than it withdrew
+	GetMarketNetIssuance(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketCollateralValue is used to get USD value of collateral deposited to the core by the market with given ID
+	GetMarketCollateralValue(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketBackingSummary is used to get net issuance, deposited collateral value and reported debt of the market with
+	// given ID. Returns OracleDataRequiredErr if market price feeds are stale
+	GetMarketBackingSummary(marketID *big.Int) (*models.MarketBackingSummary, error)
+
+	// GetMinLiquidityRatio is used to get system wide min liquidity ratio of the core as an 18 decimals number
+	GetMinLiquidityRatio() (*big.Int, error)
+
+	// GetMarketMinLiquidityRatio is used to get min liquidity ratio applied to the market with given ID. System wide
+	// value is returned with SystemDefault flag set if the market has no override
+	GetMarketMinLiquidityRatio(marketID *big.Int) (*models.MinLiquidityRatio, error)
+
+	// GetMarketMinDelegateTime is used to get min time in seconds delegation to the market with given ID must be kept
+	// before it can be decreased
+	GetMarketMinDelegateTime(marketID *big.Int) (uint32, error)
+
+	// GetCollateralConfiguration is used to get current core configuration of given collateralType. Returns NotFoundErr
+	// if the collateral type is not configured
+	GetCollateralConfiguration(collateralType common.Address) (*models.CollateralConfiguration, error)
+
+	// GetCollateralConfigurations is used to get current core configurations of all collateral types. Collaterals with
+	// depositing disabled are skipped by the contract if hideDisabled is true
+	GetCollateralConfigurations(hideDisabled bool) ([]*models.CollateralConfiguration, error)
+
+	// GetPreferredPool is used to get ID of the pool set as preferred by the core owner. Returns 0 if preferred pool is not
+	// set
+	GetPreferredPool() (*big.Int, error)
+
+	// GetApprovedPools is used to get IDs of all pools approved by the core owner. Returns empty slice if no pool is approved
+	GetApprovedPools() ([]*big.Int, error)
+
+	// GetUsdTokenAddress is used to get checksummed address of the snxUSD token used by the core. Result is cached after
+	// the first successful call
+	GetUsdTokenAddress() (string, error)
+
+	// GetOracleManagerAddress is used to get checksummed address of the oracle manager used by the core. Result is cached
+	// after the first successful call
+	GetOracleManagerAddress() (string, error)
+
+	// GetCoreAccountTokenAddress is used to get checksummed address of the core account NFT contract. Result is cached
+	// after the first successful call
+	GetCoreAccountTokenAddress() (string, error)
+
+	// GetPerpsAccountTokenAddress is used to get checksummed address of the perps market account NFT contract. Result is
+	// cached after the first successful call
+	GetPerpsAccountTokenAddress() (string, error)
+
+	// GetPoolName is used to get name of the pool with given ID. Returns PoolNotFoundErr if the pool does not exist
+	GetPoolName(poolID *big.Int) (string, error)
+
+	// GetPoolOwner is used to get checksummed address of the owner of the pool with given ID. Returns PoolNotFoundErr if
+	// the pool does not exist
+	GetPoolOwner(poolID *big.Int) (string, error)
+
+	// GetNominatedPoolOwner is used to get checksummed address nominated as the new owner of the pool with given ID.
+	// Returns blank string if nobody is nominated and PoolNotFoundErr if the pool does not exist
+	GetNominatedPoolOwner(poolID *big.Int) (string, error)
+
+	// GetPoolConfiguration is used to get current markets configuration of given pool ID. Returned entries are the same
+	// ones used by RetrievePoolConfigurationsSet so both sources can be mixed. Not configured pool returns empty slice
+	GetPoolConfiguration(poolID *big.Int) ([]*models.PoolMarketConfiguration, error)
+
+	// GetVaultCollateral is used to get vault collateral amount and its USD value for given pool ID and collateralType
+	GetVaultCollateral(poolID *big.Int, collateralType common.Address) (*models.VaultCollateral, error)
+
+	// GetVaultCollateralRatio is used to get vault collateralization ratio for given pool ID and collateralType as an 18
+	// decimals number (1.5e18 for 150%). Vaults without debt or in credit have infinite ratio which the core returns as
+	// 0, so 0 must be treated as a sentinel rather than an undercollateralized vault
+	GetVaultCollateralRatio(poolID *big.Int, collateralType common.Address) (*big.Int, error)
+
+	// GetPositionCollateral is used to get collateral amount and its USD value delegated by given core account ID to
+	// given pool ID with given collateralType
+	GetPositionCollateral(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*models.PositionCollateral, error)
+
+	// GetPositionDebt is used to get debt of the position of given core account ID in given pool ID with given
+	// collateralType. Returned value is a signed 18 decimals number and is negative when the position is in credit
+	GetPositionDebt(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*big.Int, error)
+
+	// FormatAccount is used to get account, and it's additional data from the contract by given account id
+	FormatAccount(id *big.Int) (*models.Account, error)
+
+	// FormatAccounts is used to get all accounts and their additional data from the contract
+	FormatAccounts() ([]*models.Account, error)
+
+	// FormatAccountsLimit is used to get all accounts and their additional data from the contract with given block search
+	// limit. For most public RPC providers the value for limit is 20 000 blocks
+	FormatAccountsLimit(limit uint64) ([]*models.Account, error)
+
+	// Config is used to get current lib config
+	Config() *config.PerpsvConfig
+
+	// Close used to stop the lib work
+	Close()
+}
+
+// Perpsv3 is a main perpsv3 lib object, it is implementing IPerpsv3 interface
+type Perpsv3 struct {
+	config    *config.PerpsvConfig
+	service   services.IService
+	events    events.IEvents
+	rpcClient *ethclient.Client
+}
+
+// Create used to get Perpsv3 instance with given configuration settings
+func Create(conf *config.PerpsvConfig) (IPerpsv3, error) {
+	lib := &Perpsv3{
+		config: conf,
+	}
+
+	if err := lib.init(); err != nil {
+		return nil, err
+	}
+
+	return lib, nil
+}
+
+func GetOptimismGoerliDefaultConfig(rpcURL string) *config.PerpsvConfig {
+	return config.GetOptimismGoerliDefaultConfig(rpcURL)
+}
+
+func GetBaseAndromedaDefaultConfig(rpcURL string) *config.PerpsvConfig {
+	return config.GetBaseAndromedaDefaultConfig(rpcURL)
+}
+
+func GetBaseSepoliaDefaultConfig(rpcURL string) *config.PerpsvConfig {
+	return config.GetBaseSepoliaDefaultConfig(rpcURL)
+}
+
+func GetBaseMainnetDefaultConfig(rpcURL string) *config.PerpsvConfig {
+	return config.GetBaseMainnetDefaultConfig(rpcURL)
+}
+
+func (p *Perpsv3) RetrieveTrades(fromBlock uint64, toBLock *uint64) ([]*models.Trade, error) {
+	return p.service.RetrieveTrades(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveTradesLimit(limit uint64) ([]*models.Trade, error) {
+	return p.service.RetrieveTradesLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveOrders(fromBlock uint64, toBLock *uint64) ([]*models.Order, error) {
+	return p.service.RetrieveOrders(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveOrdersLimit(limit uint64) ([]*models.Order, error) {
+	return p.service.RetrieveOrdersLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMarketUpdates(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdate, error) {
+	return p.service.RetrieveMarketUpdates(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveMarketUpdatesBig(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdateBig, error) {
+	return p.service.RetrieveMarketUpdatesBig(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveMarketUpdatesLimit(limit uint64) ([]*models.MarketUpdate, error) {
+	return p.service.RetrieveMarketUpdatesLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMarketUpdatesBigLimit(limit uint64) ([]*models.MarketUpdateBig, error) {
+	return p.service.RetrieveMarketUpdatesBigLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.Liquidation, error) {
+	return p.service.RetrieveLiquidations(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveLiquidationsLimit(limit uint64) ([]*models.Liquidation, error) {
+	return p.service.RetrieveLiquidationsLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveAccountLiquidationsLimit(limit uint64) ([]*models.AccountLiquidated, error) {
+	return p.service.RetrieveAccountLiquidationsLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveUSDMinted(fromBlock uint64, toBLock *uint64) ([]*models.USDMinted, error) {
+	return p.service.RetrieveUSDMinted(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, error) {
+	return p.service.RetrieveUSDMintedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveUSDBurned(fromBlock uint64, toBLock *uint64) ([]*models.USDBurned, error) {
+	return p.service.RetrieveUSDBurned(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, error) {
+	return p.service.RetrieveUSDBurnedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveDelegationUpdated(fromBlock uint64, toBLock *uint64) ([]*models.DelegationUpdated, error) {
+	return p.service.RetrieveDelegationUpdated(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error) {
+	return p.service.RetrieveDelegationUpdatedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveCollateralWithdrawn(fromBlock uint64, toBLock *uint64) ([]*models.CollateralWithdrawn, error) {
+	return p.service.RetrieveCollateralWithdrawn(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error) {
+	return p.service.RetrieveCollateralWithdrawnLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveCollateralDeposited(fromBlock uint64, toBLock *uint64) ([]*models.CollateralDeposited, error) {
+	return p.service.RetrieveCollateralDeposited(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error) {
+	return p.service.RetrieveCollateralDepositedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveRewardClaimed(fromBlock uint64, toBLock *uint64) ([]*models.RewardClaimed, error) {
+	return p.service.RetrieveRewardClaimed(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveRewardClaimedLimit(limit uint64) ([]*models.RewardClaimed, error) {
+	return p.service.RetrieveRewardClaimedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveRewardDistributed(fromBlock uint64, toBLock *uint64) ([]*models.RewardDistributed, error) {
+	return p.service.RetrieveRewardDistributed(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveRewardDistributedLimit(limit uint64) ([]*models.RewardDistributed, error) {
+	return p.service.RetrieveRewardDistributedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMarketUSDDepositedLimit(limit uint64) ([]*models.MarketUSDDeposited, error) {
+	return p.service.RetrieveMarketUSDDepositedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMarketUSDWithdrawnLimit(limit uint64) ([]*models.MarketUSDWithdrawn, error) {
+	return p.service.RetrieveMarketUSDWithdrawnLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveOrdersCanceled(fromBlock uint64, toBLock *uint64) ([]*models.OrderCanceled, error) {
+	return p.service.RetrieveOrdersCanceled(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveOrdersCanceledLimit(limit uint64) ([]*models.OrderCanceled, error) {
+	return p.service.RetrieveOrdersCanceledLimit(limit)
+}
+
+func (p *Perpsv3) RetrievePreviousOrderExpired(fromBlock uint64, toBLock *uint64) ([]*models.OrderExpired, error) {
+	return p.service.RetrievePreviousOrderExpired(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrievePreviousOrderExpiredLimit(limit uint64) ([]*models.OrderExpired, error) {
+	return p.service.RetrievePreviousOrderExpiredLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveCollateralModified(fromBlock uint64, toBLock *uint64) ([]*models.CollateralModified, error) {
+	return p.service.RetrieveCollateralModified(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveCollateralModifiedLimit(limit uint64) ([]*models.CollateralModified, error) {
+	return p.service.RetrieveCollateralModifiedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.AccountCreated, error) {
+	return p.service.RetrieveAccountsCreated(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveAccountsCreatedLimit(limit uint64) ([]*models.AccountCreated, error) {
+	return p.service.RetrieveAccountsCreatedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveAccountLiquidationAttempts(fromBlock uint64, toBLock *uint64) ([]*models.AccountLiquidationAttempt, error) {
+	return p.service.RetrieveAccountLiquidationAttempts(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveAccountLiquidationAttemptsLimit(limit uint64) ([]*models.AccountLiquidationAttempt, error) {
+	return p.service.RetrieveAccountLiquidationAttemptsLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveSettlementStrategiesAdded(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyAdded, error) {
+	return p.service.RetrieveSettlementStrategiesAdded(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveSettlementStrategiesAddedLimit(limit uint64) ([]*models.SettlementStrategyAdded, error) {
+	return p.service.RetrieveSettlementStrategiesAddedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveSettlementStrategyUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyUpdate, error) {
+	return p.service.RetrieveSettlementStrategyUpdates(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveSettlementStrategyUpdatesLimit(limit uint64) ([]*models.SettlementStrategyUpdate, error) {
+	return p.service.RetrieveSettlementStrategyUpdatesLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveFundingParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.FundingParameters, error) {
+	return p.service.RetrieveFundingParametersSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveFundingParametersSetLimit(limit uint64) ([]*models.FundingParameters, error) {
+	return p.service.RetrieveFundingParametersSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMarketsCreated(fromBlock uint64, toBLock *uint64) ([]*models.MarketCreated, error) {
+	return p.service.RetrieveMarketsCreated(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveMarketsCreatedLimit(limit uint64) ([]*models.MarketCreated, error) {
+	return p.service.RetrieveMarketsCreatedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.LiquidationParameters, error) {
+	return p.service.RetrieveLiquidationParametersSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveLiquidationParametersSetLimit(limit uint64) ([]*models.LiquidationParameters, error) {
+	return p.service.RetrieveLiquidationParametersSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMaxLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxLiquidationParameters, error) {
+	return p.service.RetrieveMaxLiquidationParametersSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveMaxLiquidationParametersSetLimit(limit uint64) ([]*models.MaxLiquidationParameters, error) {
+	return p.service.RetrieveMaxLiquidationParametersSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMaxMarketSizesSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxMarketSize, error) {
+	return p.service.RetrieveMaxMarketSizesSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveMaxMarketSizesSetLimit(limit uint64) ([]*models.MaxMarketSize, error) {
+	return p.service.RetrieveMaxMarketSizesSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveOrderFeesSet(fromBlock uint64, toBLock *uint64) ([]*models.OrderFees, error) {
+	return p.service.RetrieveOrderFeesSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveOrderFeesSetLimit(limit uint64) ([]*models.OrderFees, error) {
+	return p.service.RetrieveOrderFeesSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveMarketPriceDataUpdated(fromBlock uint64, toBLock *uint64) ([]*models.MarketPriceData, error) {
+	return p.service.RetrieveMarketPriceDataUpdated(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveMarketPriceDataUpdatedLimit(limit uint64) ([]*models.MarketPriceData, error) {
+	return p.service.RetrieveMarketPriceDataUpdatedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveKeeperRewardGuardsSet(fromBlock uint64, toBLock *uint64) ([]*models.KeeperRewardGuards, error) {
+	return p.service.RetrieveKeeperRewardGuardsSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveKeeperRewardGuardsSetLimit(limit uint64) ([]*models.KeeperRewardGuards, error) {
+	return p.service.RetrieveKeeperRewardGuardsSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrievePerAccountCapsSet(fromBlock uint64, toBLock *uint64) ([]*models.PerAccountCaps, error) {
+	return p.service.RetrievePerAccountCapsSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrievePerAccountCapsSetLimit(limit uint64) ([]*models.PerAccountCaps, error) {
+	return p.service.RetrievePerAccountCapsSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrievePerpsCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.PerpsCollateralConfig, error) {
+	return p.service.RetrievePerpsCollateralConfigured(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrievePerpsCollateralConfiguredLimit(limit uint64) ([]*models.PerpsCollateralConfig, error) {
+	return p.service.RetrievePerpsCollateralConfiguredLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveFeeCollectorSet(fromBlock uint64, toBLock *uint64) ([]*models.FeeCollector, error) {
+	return p.service.RetrieveFeeCollectorSet(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveFeeCollectorSetLimit(limit uint64) ([]*models.FeeCollector, error) {
+	return p.service.RetrieveFeeCollectorSetLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveReferrerSharesUpdated(fromBlock uint64, toBLock *uint64) ([]*models.ReferrerShare, error) {
+	return p.service.RetrieveReferrerSharesUpdated(fromBlock, toBLock)
+}
+
+func (p *Perpsv3) RetrieveReferrerSharesUpdatedLimit(limit uint64) ([]*models.ReferrerShare, error) {
+	return p.service.RetrieveReferrerSharesUpdatedLimit(limit)
+}
+
+func (p *Perpsv3) RetrieveSynthsBought(fromBlock uint64, toBLock *uint64) ([]*models.SynthBought, error) {
+	return p.service.RetrieveSynthsBought(fromBlock, toBLock)
+}
 
-	// ListenRewardDistributed is used to listen to all 'RewardDistributed' Core contract events and return them as models.RewardDistributed
-	// struct and return errors on ErrChan chanel
-	ListenRewardDistributed() (*events.RewardDistributedSubscription, error)
+func (p *Perpsv3) RetrieveSynthsBoughtLimit(limit uint64) ([]*models.SynthBought, error) {
+	return p.service.RetrieveSynthsBoughtLimit(limit)
+}
 
-	// ListenRewardClaimed is used to listen to all 'RewardClaimed' Core contract events and return them as models.RewardClaimed
-	// struct and return errors on ErrChan chanel
-	ListenRewardClaimed() (*events.RewardClaimedSubscription, error)
+func (p *Perpsv3) RetrieveSynthsSold(fromBlock uint64, toBLock *uint64) ([]*models.SynthSold, error) {
+	return p.service.RetrieveSynthsSold(fromBlock, toBLock)
+}
 
-	// ListenMarketUSDWithdrawn is used to listen to all 'MarketUSDWithdrawn' Core contract events and return them as models.MarketUSDWithdrawn
-	// struct and return errors on ErrChan chanel
-	ListenMarketUSDWithdrawn() (*events.MarketUSDWithdrawnSubscription, error)
+func (p *Perpsv3) RetrieveSynthsSoldLimit(limit uint64) ([]*models.SynthSold, error) {
+	return p.service.RetrieveSynthsSoldLimit(limit)
+}
 
-	// ListenMarketUSDDeposited is used to listen to all 'MarketUSDDeposited' Core contract events and return them as models.MarketUSDDeposited
-	// struct and return errors on ErrChan chanel
-	ListenMarketUSDDeposited() (*events.MarketUSDDepositedSubscription, error)
+func (p *Perpsv3) RetrieveSynthsWrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthWrapped, error) {
+	return p.service.RetrieveSynthsWrapped(fromBlock, toBLock)
+}
 
-	// GetPosition is used to get position data struct from latest block with given params
-	// Function can return contract error if market ID is invalid
-	GetPosition(accountID *big.Int, marketID *big.Int) (*models.Position, error)
+func (p *Perpsv3) RetrieveSynthsWrappedLimit(limit uint64) ([]*models.SynthWrapped, error) {
+	return p.service.RetrieveSynthsWrappedLimit(limit)
+}
 
-	// GetMarketMetadata is used to get market metadata by given market ID. Given market id cannot be nil and should exist
-	// in the smart contract
-	GetMarketMetadata(marketID *big.Int) (*models.MarketMetadata, error)
+func (p *Perpsv3) RetrieveSynthsUnwrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthUnwrapped, error) {
+	return p.service.RetrieveSynthsUnwrapped(fromBlock, toBLock)
+}
 
-	// GetMarketSummary is used to get market summary by given market ID. Given market id cannot be nil
-	GetMarketSummary(marketID *big.Int) (*models.MarketSummary, error)
+func (p *Perpsv3) RetrieveSynthsUnwrappedLimit(limit uint64) ([]*models.SynthUnwrapped, error) {
+	return p.service.RetrieveSynthsUnwrappedLimit(limit)
+}
 
-	// GetMarketIDs is used to get market IDs from the smart contract
-	GetMarketIDs() ([]*big.Int, error)
+func (p *Perpsv3) RetrieveSpotOrdersCommitted(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCommitted, error) {
+	return p.service.RetrieveSpotOrdersCommitted(fromBlock, toBLock)
+}
 
-	// GetFoundingRate is used to get current market founding rate by given market ID
-	GetFoundingRate(marketId *big.Int) (*big.Int, error)
+func (p *Perpsv3) RetrieveSpotOrdersCommittedLimit(limit uint64) ([]*models.SpotOrderCommitted, error) {
+	return p.service.RetrieveSpotOrdersCommittedLimit(limit)
+}
 
-	// GetAvailableMargin is used to get available margin for given account ID
-	GetAvailableMargin(accountId *big.Int) (*big.Int, error)
+func (p *Perpsv3) RetrieveSpotOrdersSettled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderSettled, error) {
+	return p.service.RetrieveSpotOrdersSettled(fromBlock, toBLock)
+}
 
-	// GetLiquidationParameters is used to get liquidation params for given market ID
-	GetLiquidationParameters(marketId *big.Int) (*models.LiquidationParameters, error)
+func (p *Perpsv3) RetrieveSpotOrdersSettledLimit(limit uint64) ([]*models.SpotOrderSettled, error) {
+	return p.service.RetrieveSpotOrdersSettledLimit(limit)
+}
 
-	// GetFundingParameters is used to get funding params for given market ID
-	GetFundingParameters(marketId *big.Int) (*models.FundingParameters, error)
+func (p *Perpsv3) RetrieveSpotOrdersCancelled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCancelled, error) {
+	return p.service.RetrieveSpotOrdersCancelled(fromBlock, toBLock)
+}
 
-	// GetAccountLastInteraction is used to get accounts last interaction for given account ID
-	GetAccountLastInteraction(accountId *big.Int) (*big.Int, error)
+func (p *Perpsv3) RetrieveSpotOrdersCancelledLimit(limit uint64) ([]*models.SpotOrderCancelled, error) {
+	return p.service.RetrieveSpotOrdersCancelledLimit(limit)
+}
 
-	// GetAccountOwner is used to get accounts owner address for given account ID
-	GetAccountOwner(accountId *big.Int) (string, error)
+func (p *Perpsv3) RetrieveSynthsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.SynthRegistered, error) {
+	return p.service.RetrieveSynthsRegistered(fromBlock, toBLock)
+}
 
-	// GetCollateralAmount is used to get accounts collateral amount for given market ID
-	GetCollateralAmount(accountId *big.Int, marketId *big.Int) (*big.Int, error)
+func (p *Perpsv3) RetrieveSynthsRegisteredLimit(limit uint64) ([]*models.SynthRegistered, error) {
+	return p.service.RetrieveSynthsRegisteredLimit(limit)
+}
 
-	// GetRequiredMaintenanceMargin is used to get required maintenance margin for given account ID
-	GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error)
+func (p *Perpsv3) RetrieveWrappersSet(fromBlock uint64, toBLock *uint64) ([]*models.WrapperSet, error) {
+	return p.service.RetrieveWrappersSet(fromBlock, toBLock)
+}
 
-	// GetCollateralPrice is used to get collateral price for given block number and collateralType
-	GetCollateralPrice(blockNumber *big.Int, collateralType common.Address) (*models.CollateralPrice, error)
+func (p *Perpsv3) RetrieveWrappersSetLimit(limit uint64) ([]*models.WrapperSet, error) {
+	return p.service.RetrieveWrappersSetLimit(limit)
+}
 
-	// GetVaultDebt is used to get vault debt for given pool ID and collateralType
-	GetVaultDebt(poolID *big.Int, collateralType common.Address) (*big.Int, error)
+func (p *Perpsv3) RetrieveSpotMarketFeeUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SpotFeeUpdate, error) {
+	return p.service.RetrieveSpotMarketFeeUpdates(fromBlock, toBLock)
+}
 
-	// GetVaultCollateral is used to get vault collateral for given pool ID and collateralType
-	GetVaultCollateral(poolID *big.Int, collateralType common.Address) (amount *big.Int, value *big.Int, err error)
+func (p *Perpsv3) RetrieveSpotMarketFeeUpdatesLimit(limit uint64) ([]*models.SpotFeeUpdate, error) {
+	return p.service.RetrieveSpotMarketFeeUpdatesLimit(limit)
+}
 
-	// FormatAccount is used to get account, and it's additional data from the contract by given account id
-	FormatAccount(id *big.Int) (*models.Account, error)
+func (p *Perpsv3) GetSynthAddress(synthMarketID *big.Int) (string, error) {
+	return p.service.GetSynthAddress(synthMarketID)
+}
 
-	// FormatAccounts is used to get all accounts and their additional data from the contract
-	FormatAccounts() ([]*models.Account, error)
+func (p *Perpsv3) GetSpotMarketFees(synthMarketID *big.Int) (*models.SpotMarketFees, error) {
+	return p.service.GetSpotMarketFees(synthMarketID)
+}
 
-	// FormatAccountsLimit is used to get all accounts and their additional data from the contract with given block search
-	// limit. For most public RPC providers the value for limit is 20 000 blocks
-	FormatAccountsLimit(limit uint64) ([]*models.Account, error)
+func (p *Perpsv3) GetSpotSettlementStrategy(synthMarketID *big.Int, strategyID *big.Int) (*models.SpotSettlementStrategy, error) {
+	return p.service.GetSpotSettlementStrategy(synthMarketID, strategyID)
+}
 
-	// Config is used to get current lib config
-	Config() *config.PerpsvConfig
+func (p *Perpsv3) GetQuoteBuyExactIn(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error) {
+	return p.service.GetQuoteBuyExactIn(synthMarketID, usdAmount)
+}
 
-	// Close used to stop the lib work
-	Close()
+func (p *Perpsv3) GetQuoteBuyExactOut(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error) {
+	return p.service.GetQuoteBuyExactOut(synthMarketID, synthAmount)
 }
 
-// Perpsv3 is a main perpsv3 lib object, it is implementing IPerpsv3 interface
-type Perpsv3 struct {
-	config    *config.PerpsvConfig
-	service   services.IService
-	events    events.IEvents
-	rpcClient *ethclient.Client
+func (p *Perpsv3) GetQuoteSellExactIn(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error) {
+	return p.service.GetQuoteSellExactIn(synthMarketID, synthAmount)
 }
 
-// Create used to get Perpsv3 instance with given configuration settings
-func Create(conf *config.PerpsvConfig) (IPerpsv3, error) {
-	lib := &Perpsv3{
-		config: conf,
-	}
+func (p *Perpsv3) GetQuoteSellExactOut(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error) {
+	return p.service.GetQuoteSellExactOut(synthMarketID, usdAmount)
+}
 
-	if err := lib.init(); err != nil {
-		return nil, err
-	}
+func (p *Perpsv3) RetrieveCoreAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.CoreAccountCreated, error) {
+	return p.service.RetrieveCoreAccountsCreated(fromBlock, toBLock)
+}
 
-	return lib, nil
+func (p *Perpsv3) RetrieveCoreAccountsCreatedLimit(limit uint64) ([]*models.CoreAccountCreated, error) {
+	return p.service.RetrieveCoreAccountsCreatedLimit(limit)
 }
 
-func GetOptimismGoerliDefaultConfig(rpcURL string) *config.PerpsvConfig {
-	return config.GetOptimismGoerliDefaultConfig(rpcURL)
+func (p *Perpsv3) RetrievePoolsCreated(fromBlock uint64, toBLock *uint64) ([]*models.PoolCreated, error) {
+	return p.service.RetrievePoolsCreated(fromBlock, toBLock)
 }
 
-func GetBaseAndromedaDefaultConfig(rpcURL string) *config.PerpsvConfig {
-	return config.GetBaseAndromedaDefaultConfig(rpcURL)
+func (p *Perpsv3) RetrievePoolsCreatedLimit(limit uint64) ([]*models.PoolCreated, error) {
+	return p.service.RetrievePoolsCreatedLimit(limit)
 }
 
-func GetBaseSepoliaDefaultConfig(rpcURL string) *config.PerpsvConfig {
-	return config.GetBaseSepoliaDefaultConfig(rpcURL)
+func (p *Perpsv3) RetrievePoolConfigurationsSet(fromBlock uint64, toBLock *uint64) ([]*models.PoolConfigurationSet, error) {
+	return p.service.RetrievePoolConfigurationsSet(fromBlock, toBLock)
 }
 
-func GetBaseMainnetDefaultConfig(rpcURL string) *config.PerpsvConfig {
-	return config.GetBaseMainnetDefaultConfig(rpcURL)
+func (p *Perpsv3) RetrievePoolConfigurationsSetLimit(limit uint64) ([]*models.PoolConfigurationSet, error) {
+	return p.service.RetrievePoolConfigurationsSetLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveTrades(fromBlock uint64, toBLock *uint64) ([]*models.Trade, error) {
-	return p.service.RetrieveTrades(fromBlock, toBLock)
+func (p *Perpsv3) RetrieveMarketsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.MarketRegistered, error) {
+	return p.service.RetrieveMarketsRegistered(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveTradesLimit(limit uint64) ([]*models.Trade, error) {
-	return p.service.RetrieveTradesLimit(limit)
+func (p *Perpsv3) RetrieveMarketsRegisteredLimit(limit uint64) ([]*models.MarketRegistered, error) {
+	return p.service.RetrieveMarketsRegisteredLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveOrders(fromBlock uint64, toBLock *uint64) ([]*models.Order, error) {
-	return p.service.RetrieveOrders(fromBlock, toBLock)
+func (p *Perpsv3) RetrieveMarketCollateralChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketCollateralChange, error) {
+	return p.service.RetrieveMarketCollateralChanges(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveOrdersLimit(limit uint64) ([]*models.Order, error) {
-	return p.service.RetrieveOrdersLimit(limit)
+func (p *Perpsv3) RetrieveMarketCollateralChangesLimit(limit uint64) ([]*models.MarketCollateralChange, error) {
+	return p.service.RetrieveMarketCollateralChangesLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveMarketUpdates(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdate, error) {
-	return p.service.RetrieveMarketUpdates(fromBlock, toBLock)
+func (p *Perpsv3) RetrieveMarketUSDChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketUSDChange, error) {
+	return p.service.RetrieveMarketUSDChanges(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveMarketUpdatesBig(fromBlock uint64, toBLock *uint64) ([]*models.MarketUpdateBig, error) {
-	return p.service.RetrieveMarketUpdatesBig(fromBlock, toBLock)
+func (p *Perpsv3) RetrieveMarketUSDChangesLimit(limit uint64) ([]*models.MarketUSDChange, error) {
+	return p.service.RetrieveMarketUSDChangesLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveMarketUpdatesLimit(limit uint64) ([]*models.MarketUpdate, error) {
-	return p.service.RetrieveMarketUpdatesLimit(limit)
+func (p *Perpsv3) RetrieveCoreLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.CoreLiquidation, error) {
+	return p.service.RetrieveCoreLiquidations(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveMarketUpdatesBigLimit(limit uint64) ([]*models.MarketUpdateBig, error) {
-	return p.service.RetrieveMarketUpdatesBigLimit(limit)
+func (p *Perpsv3) RetrieveCoreLiquidationsLimit(limit uint64) ([]*models.CoreLiquidation, error) {
+	return p.service.RetrieveCoreLiquidationsLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.Liquidation, error) {
-	return p.service.RetrieveLiquidations(fromBlock, toBLock)
+func (p *Perpsv3) RetrieveVaultLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.VaultLiquidation, error) {
+	return p.service.RetrieveVaultLiquidations(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveLiquidationsLimit(limit uint64) ([]*models.Liquidation, error) {
-	return p.service.RetrieveLiquidationsLimit(limit)
+func (p *Perpsv3) RetrieveVaultLiquidationsLimit(limit uint64) ([]*models.VaultLiquidation, error) {
+	return p.service.RetrieveVaultLiquidationsLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveAccountLiquidationsLimit(limit uint64) ([]*models.AccountLiquidated, error) {
-	return p.service.RetrieveAccountLiquidationsLimit(limit)
+func (p *Perpsv3) RetrievePermissionChanges(fromBlock uint64, toBLock *uint64) ([]*models.PermissionChanged, error) {
+	return p.service.RetrievePermissionChanges(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, error) {
-	return p.service.RetrieveUSDMintedLimit(limit)
+func (p *Perpsv3) RetrievePermissionChangesLimit(limit uint64) ([]*models.PermissionChanged, error) {
+	return p.service.RetrievePermissionChangesLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, error) {
-	return p.service.RetrieveUSDBurnedLimit(limit)
+func (p *Perpsv3) RetrieveCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.CollateralConfiguration, error) {
+	return p.service.RetrieveCollateralConfigured(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error) {
-	return p.service.RetrieveDelegationUpdatedLimit(limit)
+func (p *Perpsv3) RetrieveCollateralConfiguredLimit(limit uint64) ([]*models.CollateralConfiguration, error) {
+	return p.service.RetrieveCollateralConfiguredLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error) {
-	return p.service.RetrieveCollateralWithdrawnLimit(limit)
+func (p *Perpsv3) RetrieveCollateralLockChanges(fromBlock uint64, toBLock *uint64) ([]*models.CollateralLock, error) {
+	return p.service.RetrieveCollateralLockChanges(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error) {
-	return p.service.RetrieveCollateralDepositedLimit(limit)
+func (p *Perpsv3) RetrieveCollateralLockChangesLimit(limit uint64) ([]*models.CollateralLock, error) {
+	return p.service.RetrieveCollateralLockChangesLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveRewardClaimedLimit(limit uint64) ([]*models.RewardClaimed, error) {
-	return p.service.RetrieveRewardClaimedLimit(limit)
+func (p *Perpsv3) RetrievePoolOwnershipChanges(fromBlock uint64, toBLock *uint64) ([]*models.PoolOwnershipChange, error) {
+	return p.service.RetrievePoolOwnershipChanges(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveRewardDistributedLimit(limit uint64) ([]*models.RewardDistributed, error) {
-	return p.service.RetrieveRewardDistributedLimit(limit)
+func (p *Perpsv3) RetrievePoolOwnershipChangesLimit(limit uint64) ([]*models.PoolOwnershipChange, error) {
+	return p.service.RetrievePoolOwnershipChangesLimit(limit)
 }
 
-func (p *Perpsv3) RetrieveMarketUSDDepositedLimit(limit uint64) ([]*models.MarketUSDDeposited, error) {
-	return p.service.RetrieveMarketUSDDepositedLimit(limit)
+func (p *Perpsv3) RetrievePoolNameUpdates(fromBlock uint64, toBLock *uint64) ([]*models.PoolNameUpdated, error) {
+	return p.service.RetrievePoolNameUpdates(fromBlock, toBLock)
 }
 
-func (p *Perpsv3) RetrieveMarketUSDWithdrawnLimit(limit uint64) ([]*models.MarketUSDWithdrawn, error) {
-	return p.service.RetrieveMarketUSDWithdrawnLimit(limit)
+func (p *Perpsv3) RetrievePoolNameUpdatesLimit(limit uint64) ([]*models.PoolNameUpdated, error) {
+	return p.service.RetrievePoolNameUpdatesLimit(limit)
 }
 
 func (p *Perpsv3) ListenTrades() (*events.TradeSubscription, error) {
@@ -442,6 +1559,10 @@ func (p *Perpsv3) GetPosition(accountID *big.Int, marketID *big.Int) (*models.Po
 	return p.service.GetPosition(accountID, marketID)
 }
 
+func (p *Perpsv3) GetAccountOpenPositions(accountID *big.Int) ([]*models.Position, error) {
+	return p.service.GetAccountOpenPositions(accountID)
+}
+
 func (p *Perpsv3) GetMarketMetadata(marketID *big.Int) (*models.MarketMetadata, error) {
 	return p.service.GetMarketMetadata(marketID)
 }
@@ -454,14 +1575,58 @@ func (p *Perpsv3) GetMarketIDs() ([]*big.Int, error) {
 	return p.service.GetMarketIDs()
 }
 
+func (p *Perpsv3) GetMarkets() ([]*models.MarketMetadata, error) {
+	return p.service.GetMarkets()
+}
+
 func (p *Perpsv3) GetFoundingRate(marketId *big.Int) (*big.Int, error) {
 	return p.service.GetFoundingRate(marketId)
 }
 
+func (p *Perpsv3) GetFundingRate(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetFundingRate(marketID)
+}
+
+func (p *Perpsv3) GetFundingVelocity(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetFundingVelocity(marketID)
+}
+
+func (p *Perpsv3) GetMarketSize(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMarketSize(marketID)
+}
+
+func (p *Perpsv3) GetMarketSkew(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMarketSkew(marketID)
+}
+
+func (p *Perpsv3) GetMaxOpenInterest(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMaxOpenInterest(marketID)
+}
+
+func (p *Perpsv3) GetMaxMarketSize(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMaxMarketSize(marketID)
+}
+
+func (p *Perpsv3) GetIndexPrice(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetIndexPrice(marketID)
+}
+
+func (p *Perpsv3) GetFillPrice(marketID *big.Int, sizeDelta *big.Int) (*big.Int, error) {
+	return p.service.GetFillPrice(marketID, sizeDelta)
+}
+
 func (p *Perpsv3) GetAvailableMargin(accountId *big.Int) (*big.Int, error) {
 	return p.service.GetAvailableMargin(accountId)
 }
 
+func (p *Perpsv3) GetWithdrawableMargin(accountId *big.Int) (*big.Int, error) {
+	return p.service.GetWithdrawableMargin(accountId)
+}
+
+func (p *Perpsv3) GetRequiredMarginForOrder(accountId *big.Int, marketId *big.Int, sizeDelta *big.Int) (*big.Int, error) {
+	return p.service.GetRequiredMarginForOrder(accountId, marketId, sizeDelta)
+}
+
 func (p *Perpsv3) GetLiquidationParameters(marketId *big.Int) (*models.LiquidationParameters, error) {
 	return p.service.GetLiquidationParameters(marketId)
 }
@@ -470,7 +1635,19 @@ func (p *Perpsv3) GetFundingParameters(marketId *big.Int) (*models.FundingParame
 	return p.service.GetFundingParameters(marketId)
 }
 
-func (p *Perpsv3) GetAccountLastInteraction(accountId *big.Int) (*big.Int, error) {
+func (p *Perpsv3) GetOrderFees(marketId *big.Int) (*models.OrderFees, error) {
+	return p.service.GetOrderFees(marketId)
+}
+
+func (p *Perpsv3) ComputeOrderFees(marketId *big.Int, sizeDelta *big.Int) (*models.OrderFeeQuote, error) {
+	return p.service.ComputeOrderFees(marketId, sizeDelta)
+}
+
+func (p *Perpsv3) GetKeeperRewardGuards() (*models.KeeperRewardGuards, error) {
+	return p.service.GetKeeperRewardGuards()
+}
+
+func (p *Perpsv3) GetAccountLastInteraction(accountId *big.Int) (uint64, error) {
 	return p.service.GetAccountLastInteraction(accountId)
 }
 
@@ -478,14 +1655,58 @@ func (p *Perpsv3) GetAccountOwner(accountId *big.Int) (string, error) {
 	return p.service.GetAccountOwner(accountId)
 }
 
+func (p *Perpsv3) GetAccountPermissions(accountId *big.Int) ([]*models.AccountPermission, error) {
+	return p.service.GetAccountPermissions(accountId)
+}
+
+func (p *Perpsv3) HasPermission(accountId *big.Int, user string, permission string) (bool, error) {
+	return p.service.HasPermission(accountId, user, permission)
+}
+
 func (p *Perpsv3) GetCollateralAmount(accountId *big.Int, marketId *big.Int) (*big.Int, error) {
 	return p.service.GetCollateralAmount(accountId, marketId)
 }
 
+func (p *Perpsv3) GetAccountCollateralIds(accountId *big.Int) ([]*big.Int, error) {
+	return p.service.GetAccountCollateralIds(accountId)
+}
+
+func (p *Perpsv3) GetTotalCollateralValue(accountId *big.Int) (*big.Int, error) {
+	return p.service.GetTotalCollateralValue(accountId)
+}
+
+func (p *Perpsv3) GetAccountCollaterals(accountId *big.Int) ([]*models.AccountCollateral, error) {
+	return p.service.GetAccountCollaterals(accountId)
+}
+
+func (p *Perpsv3) GetSupportedCollaterals() ([]*models.PerpsCollateralConfig, error) {
+	return p.service.GetSupportedCollaterals()
+}
+
+func (p *Perpsv3) GetPendingOrder(accountId *big.Int) (*models.PendingOrder, error) {
+	return p.service.GetPendingOrder(accountId)
+}
+
+func (p *Perpsv3) GetSettlementStrategy(marketId *big.Int, strategyId *big.Int) (*models.SettlementStrategy, error) {
+	return p.service.GetSettlementStrategy(marketId, strategyId)
+}
+
 func (p *Perpsv3) GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error) {
 	return p.service.GetRequiredMaintenanceMargin(accountId)
 }
 
+func (p *Perpsv3) GetRequiredMargins(accountId *big.Int) (*models.RequiredMargins, error) {
+	return p.service.GetRequiredMargins(accountId)
+}
+
+func (p *Perpsv3) CanLiquidate(accountId *big.Int) (bool, error) {
+	return p.service.CanLiquidate(accountId)
+}
+
+func (p *Perpsv3) CanLiquidateAccounts(accountIds []*big.Int) ([]*models.LiquidationCheck, error) {
+	return p.service.CanLiquidateAccounts(accountIds)
+}
+
 func (p *Perpsv3) GetCollateralPrice(blockNumber *big.Int, collateralType common.Address) (*models.CollateralPrice, error) {
 	return p.service.GetCollateralPrice(blockNumber, collateralType)
 }
@@ -494,10 +1715,114 @@ func (p *Perpsv3) GetVaultDebt(poolID *big.Int, collateralType common.Address) (
 	return p.service.GetVaultDebt(poolID, collateralType)
 }
 
-func (p *Perpsv3) GetVaultCollateral(poolID *big.Int, collateralType common.Address) (amount *big.Int, value *big.Int, err error) {
+func (p *Perpsv3) GetMarketReportedDebt(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMarketReportedDebt(marketID)
+}
+
+func (p *Perpsv3) GetMarketTotalDebt(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMarketTotalDebt(marketID)
+}
+
+func (p *Perpsv3) GetWithdrawableMarketUsd(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetWithdrawableMarketUsd(marketID)
+}
+
+func (p *Perpsv3) GetMarketDebtSummary(marketID *big.Int) (*models.MarketDebtSummary, error) {
+	return p.service.GetMarketDebtSummary(marketID)
+}
+
+func (p *Perpsv3) GetAccountAvailableCollateral(accountID *big.Int, collateralType common.Address) (*big.Int, error) {
+	return p.service.GetAccountAvailableCollateral(accountID, collateralType)
+}
+
+func (p *Perpsv3) GetMarketNetIssuance(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMarketNetIssuance(marketID)
+}
+
+func (p *Perpsv3) GetMarketCollateralValue(marketID *big.Int) (*big.Int, error) {
+	return p.service.GetMarketCollateralValue(marketID)
+}
+
+func (p *Perpsv3) GetMarketBackingSummary(marketID *big.Int) (*models.MarketBackingSummary, error) {
+	return p.service.GetMarketBackingSummary(marketID)
+}
+
+func (p *Perpsv3) GetMinLiquidityRatio() (*big.Int, error) {
+	return p.service.GetMinLiquidityRatio()
+}
+
+func (p *Perpsv3) GetMarketMinLiquidityRatio(marketID *big.Int) (*models.MinLiquidityRatio, error) {
+	return p.service.GetMarketMinLiquidityRatio(marketID)
+}
+
+func (p *Perpsv3) GetMarketMinDelegateTime(marketID *big.Int) (uint32, error) {
+	return p.service.GetMarketMinDelegateTime(marketID)
+}
+
+func (p *Perpsv3) GetCollateralConfiguration(collateralType common.Address) (*models.CollateralConfiguration, error) {
+	return p.service.GetCollateralConfiguration(collateralType)
+}
+
+func (p *Perpsv3) GetCollateralConfigurations(hideDisabled bool) ([]*models.CollateralConfiguration, error) {
+	return p.service.GetCollateralConfigurations(hideDisabled)
+}
+
+func (p *Perpsv3) GetPreferredPool() (*big.Int, error) {
+	return p.service.GetPreferredPool()
+}
+
+func (p *Perpsv3) GetApprovedPools() ([]*big.Int, error) {
+	return p.service.GetApprovedPools()
+}
+
+func (p *Perpsv3) GetUsdTokenAddress() (string, error) {
+	return p.service.GetUsdTokenAddress()
+}
+
+func (p *Perpsv3) GetOracleManagerAddress() (string, error) {
+	return p.service.GetOracleManagerAddress()
+}
+
+func (p *Perpsv3) GetCoreAccountTokenAddress() (string, error) {
+	return p.service.GetCoreAccountTokenAddress()
+}
+
+func (p *Perpsv3) GetPerpsAccountTokenAddress() (string, error) {
+	return p.service.GetPerpsAccountTokenAddress()
+}
+
+func (p *Perpsv3) GetPoolName(poolID *big.Int) (string, error) {
+	return p.service.GetPoolName(poolID)
+}
+
+func (p *Perpsv3) GetPoolOwner(poolID *big.Int) (string, error) {
+	return p.service.GetPoolOwner(poolID)
+}
+
+func (p *Perpsv3) GetNominatedPoolOwner(poolID *big.Int) (string, error) {
+	return p.service.GetNominatedPoolOwner(poolID)
+}
+
+func (p *Perpsv3) GetPoolConfiguration(poolID *big.Int) ([]*models.PoolMarketConfiguration, error) {
+	return p.service.GetPoolConfiguration(poolID)
+}
+
+func (p *Perpsv3) GetVaultCollateral(poolID *big.Int, collateralType common.Address) (*models.VaultCollateral, error) {
 	return p.service.GetVaultCollateral(poolID, collateralType)
 }
 
+func (p *Perpsv3) GetVaultCollateralRatio(poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	return p.service.GetVaultCollateralRatio(poolID, collateralType)
+}
+
+func (p *Perpsv3) GetPositionCollateral(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*models.PositionCollateral, error) {
+	return p.service.GetPositionCollateral(accountID, poolID, collateralType)
+}
+
+func (p *Perpsv3) GetPositionDebt(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	return p.service.GetPositionDebt(accountID, poolID, collateralType)
+}
+
 func (p *Perpsv3) FormatAccounts() ([]*models.Account, error) {
 	return p.service.FormatAccounts()
 }
@@ -542,7 +1867,12 @@ func (p *Perpsv3) init() error {
 		return err
 	}
 
-	srv, err := services.NewService(rpcClient, p.config, coreContact, perpsMarketContract)
+	spotMarketContract, err := p.getSpotMarket()
+	if err != nil {
+		return err
+	}
+
+	srv, err := services.NewService(rpcClient, p.config, coreContact, perpsMarketContract, spotMarketContract)
 	if err != nil {
 		return err
 	}
@@ -595,6 +1925,28 @@ func (p *Perpsv3) getPerpsMarket() (*perpsMarket.PerpsMarket, error) {
 	}
 }
 
+// getSpotMarket is used to get spot market contract instance. Spot market is optional, so nil is returned without error
+// if there is no spot market address in the config
+func (p *Perpsv3) getSpotMarket() (*spotMarket.SpotMarket, error) {
+	if p.config.ContractAddresses.SpotMarket == "" {
+		logger.Log().WithField("layer", "Init").Warning("no spot market contract address, spot market methods are disabled")
+		return nil, nil
+	}
+
+	addr, err := getAddr(p.config.ContractAddresses.SpotMarket, "spot")
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := spotMarket.NewSpotMarket(addr, p.rpcClient)
+	if err != nil {
+		logger.Log().WithField("layer", "Init").Errorf("error getting spot market contract: %v", err.Error())
+		return nil, errors.GetInitContractErr(err)
+	}
+
+	return contract, nil
+}
+
 // getAddr is used to get contract address as common.Address type
 func getAddr(addr string, name string) (common.Address, error) {
 	isAddr := common.IsHexAddress(addr)
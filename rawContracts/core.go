@@ -13,12 +13,36 @@ import (
 
 // IRawCoreContract is an interface for the raw implementation of the core data contracts
 type IRawCoreContract interface {
+	// UnpackVaultDebt is used to unpack `getVaultDebt` return data, negative values are kept as is
 	UnpackVaultDebt(value []byte) (*big.Int, error)
+	// GetCallDataVaultDebt is used to get `getVaultDebt` call data for given pool ID and collateral type
 	GetCallDataVaultDebt(poolID *big.Int, collateralType common.Address) ([]byte, error)
+	// UnpackVaultCollateralRatio is used to unpack `getVaultCollateralRatio` return data
+	UnpackVaultCollateralRatio(value []byte) (*big.Int, error)
+	// GetCallDataVaultCollateralRatio is used to get `getVaultCollateralRatio` call data for given pool ID and
+	// collateral type
+	GetCallDataVaultCollateralRatio(poolID *big.Int, collateralType common.Address) ([]byte, error)
+	// UnpackPosition is used to unpack `getPosition` return data
+	UnpackPosition(value []byte) (*CorePosition, error)
+	// GetCallDataPosition is used to get `getPosition` call data for given account ID, pool ID and collateral type
+	GetCallDataPosition(accountID *big.Int, poolID *big.Int, collateralType common.Address) ([]byte, error)
+	// UnpackPositionDebt is used to unpack `getPositionDebt` return data, negative values are kept as is
+	UnpackPositionDebt(value []byte) (*big.Int, error)
+	// GetCallDataPositionDebt is used to get `getPositionDebt` call data for given account ID, pool ID and collateral
+	// type
+	GetCallDataPositionDebt(accountID *big.Int, poolID *big.Int, collateralType common.Address) ([]byte, error)
 	// Address is used to get perps contract address
 	Address() common.Address
 }
 
+// CorePosition is a decoded `getPosition` core return data struct
+type CorePosition struct {
+	CollateralAmount       *big.Int
+	CollateralValue        *big.Int
+	Debt                   *big.Int
+	CollateralizationRatio *big.Int
+}
+
 // Core is a raw core contract implementation
 type Core struct {
 	abi      *abi.ABI
@@ -70,6 +94,71 @@ func (p *Core) UnpackVaultDebt(value []byte) (*big.Int, error) {
 	return abi.ConvertType(unpackDebt[0], new(big.Int)).(*big.Int), nil
 }
 
+func (p *Core) GetCallDataVaultCollateralRatio(poolID *big.Int, collateralType common.Address) ([]byte, error) {
+	callDataRatio, err := p.abi.Pack("getVaultCollateralRatio", poolID, collateralType)
+	if err != nil {
+		logErr("GetCallDataVaultCollateralRatio", fmt.Sprintln("abi pack getVaultCollateralRatio err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "CoreRaw", "GetCallDataVaultCollateralRatio")
+	}
+
+	return callDataRatio, nil
+}
+
+func (p *Core) UnpackVaultCollateralRatio(value []byte) (*big.Int, error) {
+	unpackRatio, err := p.abi.Unpack("getVaultCollateralRatio", value)
+	if err != nil {
+		logErr("UnpackVaultCollateralRatio", fmt.Sprintln("abi unpack getVaultCollateralRatio err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "CoreRaw", "UnpackVaultCollateralRatio")
+	}
+
+	return abi.ConvertType(unpackRatio[0], new(big.Int)).(*big.Int), nil
+}
+
+func (p *Core) GetCallDataPosition(accountID *big.Int, poolID *big.Int, collateralType common.Address) ([]byte, error) {
+	callDataPosition, err := p.abi.Pack("getPosition", accountID, poolID, collateralType)
+	if err != nil {
+		logErr("GetCallDataPosition", fmt.Sprintln("abi pack getPosition err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "CoreRaw", "GetCallDataPosition")
+	}
+
+	return callDataPosition, nil
+}
+
+func (p *Core) UnpackPosition(value []byte) (*CorePosition, error) {
+	unpackPosition, err := p.abi.Unpack("getPosition", value)
+	if err != nil {
+		logErr("UnpackPosition", fmt.Sprintln("abi unpack getPosition err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "CoreRaw", "UnpackPosition")
+	}
+
+	return &CorePosition{
+		CollateralAmount:       abi.ConvertType(unpackPosition[0], new(big.Int)).(*big.Int),
+		CollateralValue:        abi.ConvertType(unpackPosition[1], new(big.Int)).(*big.Int),
+		Debt:                   abi.ConvertType(unpackPosition[2], new(big.Int)).(*big.Int),
+		CollateralizationRatio: abi.ConvertType(unpackPosition[3], new(big.Int)).(*big.Int),
+	}, nil
+}
+
+func (p *Core) GetCallDataPositionDebt(accountID *big.Int, poolID *big.Int, collateralType common.Address) ([]byte, error) {
+	callDataDebt, err := p.abi.Pack("getPositionDebt", accountID, poolID, collateralType)
+	if err != nil {
+		logErr("GetCallDataPositionDebt", fmt.Sprintln("abi pack getPositionDebt err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "CoreRaw", "GetCallDataPositionDebt")
+	}
+
+	return callDataDebt, nil
+}
+
+func (p *Core) UnpackPositionDebt(value []byte) (*big.Int, error) {
+	unpackDebt, err := p.abi.Unpack("getPositionDebt", value)
+	if err != nil {
+		logErr("UnpackPositionDebt", fmt.Sprintln("abi unpack getPositionDebt err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "CoreRaw", "UnpackPositionDebt")
+	}
+
+	return abi.ConvertType(unpackDebt[0], new(big.Int)).(*big.Int), nil
+}
+
 func (p *Core) Address() common.Address {
 	return p.address
 }
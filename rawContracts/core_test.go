@@ -0,0 +1,129 @@
+package rawContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCore_UnpackVaultDebt(t *testing.T) {
+	c, err := NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	core := c.(*Core)
+
+	credit, _ := new(big.Int).SetString("-250000000000000000000000", 10)
+
+	testCases := []struct {
+		name string
+		debt *big.Int
+	}{
+		{
+			name: "positive debt",
+			debt: big.NewInt(1000000000000000000),
+		},
+		{
+			name: "zero debt",
+			debt: big.NewInt(0),
+		},
+		{
+			name: "credit",
+			debt: credit,
+		},
+		{
+			name: "minus one wei debt",
+			debt: big.NewInt(-1),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := core.abi.Methods["getVaultDebt"].Outputs.Pack(tt.debt)
+			require.NoError(t, err)
+
+			res, err := core.UnpackVaultDebt(data)
+
+			require.NoError(t, err)
+			require.Equal(t, 0, tt.debt.Cmp(res))
+			require.Equal(t, tt.debt.Sign(), res.Sign())
+		})
+	}
+}
+
+func TestCore_UnpackVaultCollateralRatio(t *testing.T) {
+	c, err := NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	core := c.(*Core)
+
+	// getVaultCollateralRatio return data fixture for the vault collateralized at 525%
+	healthy := common.FromHex("0x00000000000000000000000000000000000000000000000048dbbf2f2ecd0000")
+	// core returns 0 instead of the infinite ratio for vaults without debt
+	zeroDebt := common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000000")
+
+	res, err := core.UnpackVaultCollateralRatio(healthy)
+
+	require.NoError(t, err)
+	require.Equal(t, "5250000000000000000", res.String())
+
+	res, err = core.UnpackVaultCollateralRatio(zeroDebt)
+
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Sign())
+
+	_, err = core.UnpackVaultCollateralRatio([]byte{1})
+
+	require.Error(t, err)
+}
+
+func TestCore_GetCallDataVaultCollateralRatio(t *testing.T) {
+	c, err := NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	data, err := c.GetCallDataVaultCollateralRatio(big.NewInt(1), common.HexToAddress("0xC74eA762cF06c9151cE074E6a569a5945b6302E7"))
+
+	require.NoError(t, err)
+	require.Equal(t, c.(*Core).abi.Methods["getVaultCollateralRatio"].ID, data[:4])
+	require.Len(t, data, 4+32*2)
+}
+
+func TestCore_UnpackPosition(t *testing.T) {
+	c, err := NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	core := c.(*Core)
+
+	amount, _ := new(big.Int).SetString("1500000000000000000000", 10)
+	value, _ := new(big.Int).SetString("3000000000000000000000000", 10)
+	credit, _ := new(big.Int).SetString("-1200000000000000000000", 10)
+
+	data, err := core.abi.Methods["getPosition"].Outputs.Pack(amount, value, credit, big.NewInt(0))
+	require.NoError(t, err)
+
+	res, err := core.UnpackPosition(data)
+
+	require.NoError(t, err)
+	require.Equal(t, 0, amount.Cmp(res.CollateralAmount))
+	require.Equal(t, 0, value.Cmp(res.CollateralValue))
+	require.Equal(t, 0, credit.Cmp(res.Debt))
+	require.Equal(t, 0, res.CollateralizationRatio.Sign())
+}
+
+func TestCore_UnpackPositionDebt(t *testing.T) {
+	c, err := NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	core := c.(*Core)
+
+	for _, debt := range []*big.Int{big.NewInt(1000000000000000000), big.NewInt(0), big.NewInt(-1)} {
+		data, err := core.abi.Methods["getPositionDebt"].Outputs.Pack(debt)
+		require.NoError(t, err)
+
+		res, err := core.UnpackPositionDebt(data)
+
+		require.NoError(t, err)
+		require.Equal(t, 0, debt.Cmp(res))
+	}
+}
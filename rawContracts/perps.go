@@ -22,9 +22,17 @@ type IRawPerpsContract interface {
 	GetCallDataRequiredMargins(accountID *big.Int) ([]byte, error)
 	//
 	GetCallDataAvailableMargin(accountID *big.Int) ([]byte, error)
+	// GetCallDataIndexPrice is used to get calldata for the indexPrice method
+	GetCallDataIndexPrice(marketID *big.Int) ([]byte, error)
+	// GetCallDataCurrentFundingRate is used to get calldata for the currentFundingRate method
+	GetCallDataCurrentFundingRate(marketID *big.Int) ([]byte, error)
+	// GetCallDataFillPrice is used to get calldata for the fillPrice method with given signed order size and price
+	GetCallDataFillPrice(marketID *big.Int, orderSize *big.Int, price *big.Int) ([]byte, error)
+	// GetCallDataCanLiquidate is used to get calldata for the canLiquidate method
+	GetCallDataCanLiquidate(accountID *big.Int) ([]byte, error)
 	// UnpackGetMarketSummary is used to unpack outputs for the getMarketSummary method
 	UnpackGetMarketSummary(value []byte) (res *perpsMarket.IPerpsMarketModuleMarketSummary, err error)
-	//
+	// UnpackAvailableMargin is used to unpack signed output for the getAvailableMargin method
 	UnpackAvailableMargin(value []byte) (res *big.Int, err error)
 	//
 	UnpackRequiredMargins(value []byte) (res struct {
@@ -32,6 +40,14 @@ type IRawPerpsContract interface {
 		RequiredMaintenanceMargin *big.Int
 		MaxLiquidationReward      *big.Int
 	}, err error)
+	// UnpackIndexPrice is used to unpack output for the indexPrice method
+	UnpackIndexPrice(value []byte) (res *big.Int, err error)
+	// UnpackCurrentFundingRate is used to unpack signed output for the currentFundingRate method
+	UnpackCurrentFundingRate(value []byte) (res *big.Int, err error)
+	// UnpackFillPrice is used to unpack output for the fillPrice method
+	UnpackFillPrice(value []byte) (res *big.Int, err error)
+	// UnpackCanLiquidate is used to unpack output for the canLiquidate method
+	UnpackCanLiquidate(value []byte) (res bool, err error)
 	// UnpackOpenPosition is used to unpack outputs for the getOpenPosition method
 	UnpackOpenPosition(value []byte) (res struct {
 		TotalPnl       *big.Int
@@ -117,6 +133,79 @@ func (p *Perps) GetCallDataOpenPosition(marketID *big.Int, accountID *big.Int) (
 	return callOpenPosition, nil
 }
 
+func (p *Perps) GetCallDataIndexPrice(marketID *big.Int) ([]byte, error) {
+	callDataIndexPrice, err := p.abi.Pack("indexPrice", marketID)
+	if err != nil {
+		logErr("GetCallDataIndexPrice", fmt.Sprintln("abi pack indexPrice err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "PerpsRaw", "indexPrice")
+	}
+
+	return callDataIndexPrice, nil
+}
+
+func (p *Perps) GetCallDataCurrentFundingRate(marketID *big.Int) ([]byte, error) {
+	callDataFundingRate, err := p.abi.Pack("currentFundingRate", marketID)
+	if err != nil {
+		logErr("GetCallDataCurrentFundingRate", fmt.Sprintln("abi pack currentFundingRate err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "PerpsRaw", "currentFundingRate")
+	}
+
+	return callDataFundingRate, nil
+}
+
+func (p *Perps) GetCallDataFillPrice(marketID *big.Int, orderSize *big.Int, price *big.Int) ([]byte, error) {
+	callDataFillPrice, err := p.abi.Pack("fillPrice", marketID, orderSize, price)
+	if err != nil {
+		logErr("GetCallDataFillPrice", fmt.Sprintln("abi pack fillPrice err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "PerpsRaw", "fillPrice")
+	}
+
+	return callDataFillPrice, nil
+}
+
+func (p *Perps) GetCallDataCanLiquidate(accountID *big.Int) ([]byte, error) {
+	callDataCanLiquidate, err := p.abi.Pack("canLiquidate", accountID)
+	if err != nil {
+		logErr("GetCallDataCanLiquidate", fmt.Sprintln("abi pack canLiquidate err:", err.Error()))
+		return nil, errors.GetReadContractErr(err, "PerpsRaw", "canLiquidate")
+	}
+
+	return callDataCanLiquidate, nil
+}
+
+func (p *Perps) UnpackIndexPrice(value []byte) (res *big.Int, err error) {
+	return p.unpackBigInt("indexPrice", "UnpackIndexPrice", value)
+}
+
+func (p *Perps) UnpackCurrentFundingRate(value []byte) (res *big.Int, err error) {
+	return p.unpackBigInt("currentFundingRate", "UnpackCurrentFundingRate", value)
+}
+
+func (p *Perps) UnpackFillPrice(value []byte) (res *big.Int, err error) {
+	return p.unpackBigInt("fillPrice", "UnpackFillPrice", value)
+}
+
+func (p *Perps) UnpackCanLiquidate(value []byte) (res bool, err error) {
+	unpackedCanLiquidate, err := p.abi.Unpack("canLiquidate", value)
+	if err != nil {
+		logErr("UnpackCanLiquidate", fmt.Sprintln("abi unpack canLiquidate err:", err.Error()))
+		return res, errors.GetReadContractErr(err, "PerpsRaw", "UnpackCanLiquidate")
+	}
+
+	return *abi.ConvertType(unpackedCanLiquidate[0], new(bool)).(*bool), nil
+}
+
+// unpackBigInt is used to unpack single int or uint output of given method
+func (p *Perps) unpackBigInt(method string, layer string, value []byte) (res *big.Int, err error) {
+	unpacked, err := p.abi.Unpack(method, value)
+	if err != nil {
+		logErr(layer, fmt.Sprintf("abi unpack %v err: %v\n", method, err.Error()))
+		return res, errors.GetReadContractErr(err, "PerpsRaw", layer)
+	}
+
+	return *abi.ConvertType(unpacked[0], new(*big.Int)).(**big.Int), nil
+}
+
 func (p *Perps) UnpackGetMarketSummary(value []byte) (res *perpsMarket.IPerpsMarketModuleMarketSummary, err error) {
 	unpackedSummary, err := p.abi.Unpack("getMarketSummary", value)
 	if err != nil {
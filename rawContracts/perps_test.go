@@ -0,0 +1,104 @@
+package rawContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerps_UnpackAvailableMargin(t *testing.T) {
+	c, err := NewPerps(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), nil)
+	require.NoError(t, err)
+
+	perps := c.(*Perps)
+
+	underwater, _ := new(big.Int).SetString("-1520340000000000000000", 10)
+
+	testCases := []struct {
+		name   string
+		margin *big.Int
+	}{
+		{
+			name:   "positive margin",
+			margin: big.NewInt(1000000000000000000),
+		},
+		{
+			name:   "zero margin",
+			margin: big.NewInt(0),
+		},
+		{
+			name:   "negative margin",
+			margin: underwater,
+		},
+		{
+			name:   "minus one wei margin",
+			margin: big.NewInt(-1),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := perps.abi.Methods["getAvailableMargin"].Outputs.Pack(tt.margin)
+			require.NoError(t, err)
+
+			res, err := perps.UnpackAvailableMargin(data)
+
+			require.NoError(t, err)
+			require.Equal(t, 0, tt.margin.Cmp(res))
+			require.Equal(t, tt.margin.Sign(), res.Sign())
+		})
+	}
+}
+
+func TestPerps_UnpackAvailableMargin_InvalidData(t *testing.T) {
+	c, err := NewPerps(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), nil)
+	require.NoError(t, err)
+
+	_, err = c.UnpackAvailableMargin([]byte{1, 2, 3})
+
+	require.Error(t, err)
+}
+
+func TestPerps_OracleViewsCallData(t *testing.T) {
+	c, err := NewPerps(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), nil)
+	require.NoError(t, err)
+
+	perps := c.(*Perps)
+
+	price, _ := new(big.Int).SetString("2250750000000000000000", 10)
+	fundingRate, _ := new(big.Int).SetString("-125000000000000", 10)
+
+	indexPrice, err := c.GetCallDataIndexPrice(big.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, perps.abi.Methods["indexPrice"].ID, indexPrice[:4])
+
+	fillPrice, err := c.GetCallDataFillPrice(big.NewInt(100), big.NewInt(-1), price)
+	require.NoError(t, err)
+	require.Equal(t, perps.abi.Methods["fillPrice"].ID, fillPrice[:4])
+
+	data, err := perps.abi.Methods["indexPrice"].Outputs.Pack(price)
+	require.NoError(t, err)
+
+	res, err := c.UnpackIndexPrice(data)
+	require.NoError(t, err)
+	require.Equal(t, price, res)
+
+	data, err = perps.abi.Methods["currentFundingRate"].Outputs.Pack(fundingRate)
+	require.NoError(t, err)
+
+	res, err = c.UnpackCurrentFundingRate(data)
+	require.NoError(t, err)
+	require.Equal(t, fundingRate, res)
+
+	data, err = perps.abi.Methods["canLiquidate"].Outputs.Pack(true)
+	require.NoError(t, err)
+
+	canLiquidate, err := c.UnpackCanLiquidate(data)
+	require.NoError(t, err)
+	require.True(t, canLiquidate)
+
+	_, err = c.UnpackFillPrice([]byte{1, 2, 3})
+	require.Error(t, err)
+}
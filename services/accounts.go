@@ -1,14 +1,18 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/forwarder"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
@@ -274,31 +278,40 @@ func (s *Service) getAvailableMargin(accountId *big.Int) (*big.Int, error) {
 }
 
 func (s *Service) GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error) {
-	return s.getRequiredMaintenanceMarginRetries(accountId, 0)
+	margins, err := s.GetRequiredMargins(accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	return margins.RequiredMaintenanceMargin, nil
 }
 
-func (s *Service) getRequiredMaintenanceMarginRetries(accountId *big.Int, fails int) (res *big.Int, err error) {
+func (s *Service) GetRequiredMargins(accountId *big.Int) (*models.RequiredMargins, error) {
+	return s.getRequiredMarginsRetries(accountId, 0)
+}
+
+func (s *Service) getRequiredMarginsRetries(accountId *big.Int, fails int) (res *models.RequiredMargins, err error) {
 	switch {
 	case s.chainID == config.BaseAndromeda || s.chainID == config.BaseSepolia:
-		res, err = s.getRequiredMaintenanceMarginMulticallNoPyth(accountId, true)
+		res, err = s.getRequiredMarginsMulticallNoPyth(accountId, true)
 		if err != nil && fails <= s.multicallRetries {
 			time.Sleep(s.multicallWait)
-			return s.getRequiredMaintenanceMarginRetries(accountId, fails+1)
+			return s.getRequiredMarginsRetries(accountId, fails+1)
 		}
 	case s.chainID == config.BaseMainnet:
-		res, err = s.getRequiredMaintenanceMarginMulticall(accountId, true)
+		res, err = s.getRequiredMarginsMulticall(accountId, true)
 		if err != nil && fails <= s.multicallRetries {
 			time.Sleep(s.multicallWait)
-			return s.getRequiredMaintenanceMarginRetries(accountId, fails+1)
+			return s.getRequiredMarginsRetries(accountId, fails+1)
 		}
 	default:
-		res, err = s.getRequiredMaintenanceMargin(accountId)
+		res, err = s.getRequiredMargins(accountId)
 	}
 
 	return res, err
 }
 
-func (s *Service) getRequiredMaintenanceMarginMulticallNoPyth(accountId *big.Int, retries bool) (res *big.Int, err error) {
+func (s *Service) getRequiredMarginsMulticallNoPyth(accountId *big.Int, retries bool) (res *models.RequiredMargins, err error) {
 	getMarginsCallData, err := s.rawPerpsContract.GetCallDataRequiredMargins(accountId)
 	if err != nil {
 		return res, err
@@ -314,18 +327,18 @@ func (s *Service) getRequiredMaintenanceMarginMulticallNoPyth(accountId *big.Int
 	call, err := s.rawForwarder.Aggregate3Value(0, []forwarder.TrustedMulticallForwarderCall3Value{callMargins})
 	if err != nil {
 		if retries {
-			return s.getRequiredMaintenanceMarginMulticall(accountId, false)
+			return s.getRequiredMarginsMulticall(accountId, false)
 		}
 		return res, err
 	}
 
 	if len(call) != 1 {
-		logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticallNoPyth").Errorf("received %v from rawForwarder contract, expected 2", len(call))
+		logger.Log().WithField("layer", "getRequiredMarginsMulticallNoPyth").Errorf("received %v from rawForwarder contract, expected 2", len(call))
 		return res, errors.GetReadContractErr(fmt.Errorf("invalid call"), "rawForwarder", "Aggregate3Value")
 	}
 
 	if !call[0].Success {
-		logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticallNoPyth").Error("call to erc7412 unsuccessful")
+		logger.Log().WithField("layer", "getRequiredMarginsMulticallNoPyth").Error("call to erc7412 unsuccessful")
 		return res, errors.GetReadContractErr(fmt.Errorf("invalid call to perps"), "rawForwarder", "Aggregate3Value")
 	}
 
@@ -334,10 +347,14 @@ func (s *Service) getRequiredMaintenanceMarginMulticallNoPyth(accountId *big.Int
 		return res, err
 	}
 
-	return unpackedMargins.RequiredMaintenanceMargin, nil
+	return models.GetRequiredMarginsFromContract(
+		unpackedMargins.RequiredInitialMargin,
+		unpackedMargins.RequiredMaintenanceMargin,
+		unpackedMargins.MaxLiquidationReward,
+	), nil
 }
 
-func (s *Service) getRequiredMaintenanceMarginMulticall(accountId *big.Int, retries bool) (res *big.Int, err error) {
+func (s *Service) getRequiredMarginsMulticall(accountId *big.Int, retries bool) (res *models.RequiredMargins, err error) {
 	getMarginsCallData, err := s.rawPerpsContract.GetCallDataRequiredMargins(accountId)
 	if err != nil {
 		return res, err
@@ -359,7 +376,7 @@ func (s *Service) getRequiredMaintenanceMarginMulticall(accountId *big.Int, retr
 	for _, m := range marketIDs {
 		feedID := models.GetPriceFeedIDFromMarketID(m)
 		if feedID == models.UNKNOWN {
-			logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticall").Errorf(
+			logger.Log().WithField("layer", "getRequiredMarginsMulticall").Errorf(
 				"market ud: %v not supported on andromeda net", m.String(),
 			)
 			return res, errors.GetReadContractErr(fmt.Errorf("market %v not supported", m.String()), "rawForwarder", "Aggregate3Value")
@@ -370,7 +387,7 @@ func (s *Service) getRequiredMaintenanceMarginMulticall(accountId *big.Int, retr
 
 	fulfillOracleQueryCallData, err := s.rawERC7412.GetCallFulfillOracleQueryAll(feedIDs)
 	if err != nil {
-		logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticall").Errorf(
+		logger.Log().WithField("layer", "getRequiredMarginsMulticall").Errorf(
 			"err GetCallFulfillOracleQueryAll",
 		)
 		return res, err
@@ -386,23 +403,23 @@ func (s *Service) getRequiredMaintenanceMarginMulticall(accountId *big.Int, retr
 	call, err := s.rawForwarder.Aggregate3Value(2, []forwarder.TrustedMulticallForwarderCall3Value{callFulfill, callMargins})
 	if err != nil {
 		if retries {
-			return s.getRequiredMaintenanceMarginMulticallNoPyth(accountId, false)
+			return s.getRequiredMarginsMulticallNoPyth(accountId, false)
 		}
 		return res, err
 	}
 
 	if len(call) != 2 {
-		logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticall").Errorf("received %v from rawForwarder contract, expected 2", len(call))
+		logger.Log().WithField("layer", "getRequiredMarginsMulticall").Errorf("received %v from rawForwarder contract, expected 2", len(call))
 		return res, errors.GetReadContractErr(fmt.Errorf("invalid call"), "rawForwarder", "Aggregate3Value")
 	}
 
 	if !call[0].Success {
-		logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticall").Error("call to erc7412 unsuccessful")
+		logger.Log().WithField("layer", "getRequiredMarginsMulticall").Error("call to erc7412 unsuccessful")
 		return res, errors.GetReadContractErr(fmt.Errorf("invalid call to erc7412"), "rawForwarder", "Aggregate3Value")
 	}
 
 	if !call[1].Success {
-		logger.Log().WithField("layer", "getRequiredMaintenanceMarginMulticall").Error("call to perps unsuccessful")
+		logger.Log().WithField("layer", "getRequiredMarginsMulticall").Error("call to perps unsuccessful")
 		return res, errors.GetReadContractErr(fmt.Errorf("invalid call to perps market"), "rawForwarder", "Aggregate3Value")
 	}
 
@@ -411,10 +428,14 @@ func (s *Service) getRequiredMaintenanceMarginMulticall(accountId *big.Int, retr
 		return res, err
 	}
 
-	return unpackedMargins.RequiredMaintenanceMargin, nil
+	return models.GetRequiredMarginsFromContract(
+		unpackedMargins.RequiredInitialMargin,
+		unpackedMargins.RequiredMaintenanceMargin,
+		unpackedMargins.MaxLiquidationReward,
+	), nil
 }
 
-func (s *Service) getRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error) {
+func (s *Service) getRequiredMargins(accountId *big.Int) (*models.RequiredMargins, error) {
 	requiredMargins, err := s.perpsMarket.GetRequiredMargins(nil, accountId)
 	if err != nil {
 		logger.Log().WithField("layer", "").Errorf("get required margins error: %v", err.Error())
@@ -425,30 +446,100 @@ func (s *Service) getRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, er
 			"perps market", "GetRequiredMargins")
 	}
 
-	return requiredMargins.RequiredMaintenanceMargin, nil
+	return models.GetRequiredMarginsFromContract(
+		requiredMargins.RequiredInitialMargin,
+		requiredMargins.RequiredMaintenanceMargin,
+		requiredMargins.MaxLiquidationReward,
+	), nil
 }
 
-func (s *Service) GetAccountLastInteraction(accountId *big.Int) (*big.Int, error) {
+func (s *Service) GetAccountLastInteraction(accountId *big.Int) (uint64, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetAccountLastInteraction").Errorf("received nil account id")
+		return 0, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
 	time, err := s.perpsMarket.GetAccountLastInteraction(nil, accountId)
 	if err != nil {
 		logger.Log().WithField("layer", "Service-GetAccountLastInteraction").Errorf("get account last interaction error: %v", err.Error())
-		return nil, errors.GetReadContractErr(err, "perps market", "GetAccountLastInteraction")
+		return 0, errors.GetReadContractErr(err, "perps market", "GetAccountLastInteraction")
 	}
 
-	return time, nil
+	return time.Uint64(), nil
 }
 
 func (s *Service) GetAccountOwner(accountId *big.Int) (string, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetAccountOwner").Errorf("received nil account id")
+		return "", errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
 	owner, err := s.perpsMarket.GetAccountOwner(nil, accountId)
 	if err != nil {
+		if isRevertErr(err, accountNotFoundSelector) {
+			logger.Log().WithField("layer", "Service-GetAccountOwner").Errorf("account %v not found", accountId.String())
+			return "", errors.AccountNotFoundErr
+		}
+
 		logger.Log().WithField("layer", "Service-GetAccountOwner").Errorf("get account owner error: %v", err.Error())
 		return "", errors.GetReadContractErr(err, "perps market", "GetAccountOwner")
 	}
 
+	// contract returns zero address for burned and not existing accounts instead of reverting
+	if owner == (common.Address{}) {
+		logger.Log().WithField("layer", "Service-GetAccountOwner").Errorf("account %v not found", accountId.String())
+		return "", errors.AccountNotFoundErr
+	}
+
 	return owner.Hex(), nil
 }
 
+func (s *Service) GetAccountPermissions(accountId *big.Int) ([]*models.AccountPermission, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetAccountPermissions").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	permissions, err := s.perpsMarket.GetAccountPermissions(nil, accountId)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetAccountPermissions").Errorf("get account permissions error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "GetAccountPermissions")
+	}
+
+	return models.GetAccountPermissions(permissions), nil
+}
+
+func (s *Service) HasPermission(accountId *big.Int, user string, permission string) (bool, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-HasPermission").Errorf("received nil account id")
+		return false, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	if !common.IsHexAddress(user) {
+		logger.Log().WithField("layer", "Service-HasPermission").Errorf("received invalid user address: %v", user)
+		return false, errors.GetInvalidArgumentErr("user is not a valid address")
+	}
+
+	p, err := models.PermissionFromString(permission)
+	if err != nil {
+		return false, err
+	}
+
+	has, err := s.perpsMarket.HasPermission(nil, accountId, p.Bytes(), common.HexToAddress(user))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-HasPermission").Errorf("has permission error: %v", err.Error())
+		return false, errors.GetReadContractErr(err, "perps market", "HasPermission")
+	}
+
+	return has, nil
+}
+
 func (s *Service) GetCollateralAmount(accountId *big.Int, marketId *big.Int) (*big.Int, error) {
+	if accountId == nil || marketId == nil {
+		logger.Log().WithField("layer", "Service-GetCollateralAmount").Errorf("received nil account id or market id")
+		return nil, errors.GetInvalidArgumentErr("account id and market id cannot be nil")
+	}
+
 	amount, err := s.perpsMarket.GetCollateralAmount(nil, accountId, marketId)
 	if err != nil {
 		logger.Log().WithField("layer", "Service-GetCollateralAmount").Errorf("get colleteral amount error: %v", err.Error())
@@ -458,6 +549,105 @@ func (s *Service) GetCollateralAmount(accountId *big.Int, marketId *big.Int) (*b
 	return amount, nil
 }
 
+func (s *Service) GetWithdrawableMargin(accountId *big.Int) (*big.Int, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetWithdrawableMargin").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	margin, err := s.perpsMarket.GetWithdrawableMargin(nil, accountId)
+	if err != nil {
+		if isRevertErr(err, accountNotFoundSelector) {
+			logger.Log().WithField("layer", "Service-GetWithdrawableMargin").Errorf("account %v not found", accountId.String())
+			return nil, errors.AccountNotFoundErr
+		}
+
+		logger.Log().WithField("layer", "Service-GetWithdrawableMargin").Errorf("get withdrawable margin error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "GetWithdrawableMargin")
+	}
+
+	return margin, nil
+}
+
+func (s *Service) GetRequiredMarginForOrder(accountId *big.Int, marketId *big.Int, sizeDelta *big.Int) (*big.Int, error) {
+	if accountId == nil || marketId == nil || sizeDelta == nil {
+		logger.Log().WithField("layer", "Service-GetRequiredMarginForOrder").Errorf("received nil argument")
+		return nil, errors.GetInvalidArgumentErr("account id, market id and size delta cannot be nil")
+	}
+
+	margin, err := s.perpsMarket.RequiredMarginForOrder(nil, accountId, marketId, sizeDelta)
+	if err != nil {
+		if isRevertErr(err, accountNotFoundSelector) {
+			logger.Log().WithField("layer", "Service-GetRequiredMarginForOrder").Errorf("account %v not found", accountId.String())
+			return nil, errors.AccountNotFoundErr
+		}
+
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-GetRequiredMarginForOrder").Errorf("oracle data required")
+			return nil, errors.GetOracleDataRequiredErr(err, "perps market", "RequiredMarginForOrder")
+		}
+
+		logger.Log().WithField("layer", "Service-GetRequiredMarginForOrder").Errorf("get required margin for order error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "RequiredMarginForOrder")
+	}
+
+	return margin, nil
+}
+
+func (s *Service) GetAccountCollateralIds(accountId *big.Int) ([]*big.Int, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetAccountCollateralIds").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	ids, err := s.perpsMarket.GetAccountCollateralIds(nil, accountId)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetAccountCollateralIds").Errorf("get account collateral ids error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "GetAccountCollateralIds")
+	}
+
+	return ids, nil
+}
+
+func (s *Service) GetTotalCollateralValue(accountId *big.Int) (*big.Int, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetTotalCollateralValue").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	value, err := s.perpsMarket.TotalCollateralValue(nil, accountId)
+	if err != nil {
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-GetTotalCollateralValue").Errorf("oracle data required")
+			return nil, errors.GetOracleDataRequiredErr(err, "perps market", "TotalCollateralValue")
+		}
+
+		logger.Log().WithField("layer", "Service-GetTotalCollateralValue").Errorf("get total collateral value error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "TotalCollateralValue")
+	}
+
+	return value, nil
+}
+
+func (s *Service) GetAccountCollaterals(accountId *big.Int) ([]*models.AccountCollateral, error) {
+	ids, err := s.GetAccountCollateralIds(accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	collaterals := []*models.AccountCollateral{}
+	for _, id := range ids {
+		amount, err := s.GetCollateralAmount(accountId, id)
+		if err != nil {
+			return nil, err
+		}
+
+		collaterals = append(collaterals, &models.AccountCollateral{SynthMarketID: id, Amount: amount})
+	}
+
+	return collaterals, nil
+}
+
 // formatAccounts is used to get accounts from the contract using event filter function for 'AccountCreated' event
 // and given filter options
 func (s *Service) formatAccounts(opts *bind.FilterOpts) ([]*models.Account, error) {
@@ -508,3 +698,177 @@ func (s *Service) formatAccount(id *big.Int) (*models.Account, error) {
 
 	return models.FormatAccount(id, owner, time.Uint64(), permissions), nil
 }
+
+func (s *Service) RetrieveAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.AccountCreated, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveAccountsCreated(opts)
+}
+
+func (s *Service) RetrieveAccountsCreatedLimit(limit uint64) ([]*models.AccountCreated, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []*models.AccountCreated
+
+	logger.Log().WithField("layer", "Service-RetrieveAccountsCreatedLimit").Infof(
+		"fetching created accounts with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveAccountsCreatedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveAccountsCreated(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveAccountsCreatedLimit").Infof("task completed successfully")
+
+	return accounts, nil
+}
+
+// retrieveAccountsCreated is used to retrieve created accounts with given filter options
+func (s *Service) retrieveAccountsCreated(opts *bind.FilterOpts) ([]*models.AccountCreated, error) {
+	iterator, err := s.perpsMarket.FilterAccountCreated(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveAccountsCreated").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var accounts []*models.AccountCreated
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveAccountsCreated").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		account, err := s.getAccountsCreated(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// getAccountsCreated is used to get models.AccountCreated from given event and block number
+func (s *Service) getAccountsCreated(event *perpsMarket.PerpsMarketAccountCreated, blockN uint64) (*models.AccountCreated, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveAccountsCreated").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetAccountCreatedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveCoreAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.CoreAccountCreated, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveCoreAccountsCreated(opts)
+}
+
+func (s *Service) RetrieveCoreAccountsCreatedLimit(limit uint64) ([]*models.CoreAccountCreated, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []*models.CoreAccountCreated
+
+	logger.Log().WithField("layer", "Service-RetrieveCoreAccountsCreatedLimit").Infof(
+		"fetching created core accounts with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveCoreAccountsCreatedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveCoreAccountsCreated(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveCoreAccountsCreatedLimit").Infof("task completed successfully")
+
+	return accounts, nil
+}
+
+// retrieveCoreAccountsCreated is used to retrieve created core accounts with given filter options
+func (s *Service) retrieveCoreAccountsCreated(opts *bind.FilterOpts) ([]*models.CoreAccountCreated, error) {
+	iterator, err := s.core.FilterAccountCreated(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCoreAccountsCreated").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var accounts []*models.CoreAccountCreated
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveCoreAccountsCreated").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		account, err := s.getCoreAccountCreated(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+// getCoreAccountCreated is used to get models.CoreAccountCreated from given event and block number
+func (s *Service) getCoreAccountCreated(event *core.CoreAccountCreated, blockN uint64) (*models.CoreAccountCreated, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCoreAccountsCreated").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetCoreAccountCreatedFromEvent(event, block.Time), nil
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 )
 
@@ -59,7 +61,7 @@ func TestService_FormatAccount_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 			res, err := s.FormatAccount(tt.id)
 
@@ -86,9 +88,493 @@ func TestService_FormatAccounts_OnChain_Limit(t *testing.T) {
 	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
 	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
 
-	s, _ := NewService(rpcClient, conf, coreC, perps)
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 	_, err := s.FormatAccountsLimit(20000)
 
 	require.NoError(t, err)
 }
+
+func TestService_RetrieveAccountsCreated_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveAccountsCreatedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveCoreAccountsCreated_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveCoreAccountsCreatedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrievePermissionChanges_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePermissionChangesLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_GetWithdrawableMargin(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	// max uint128 value is never reached by the perps account id counter
+	notExistingID, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:    "not existing account",
+			id:      notExistingID,
+			wantErr: errors.AccountNotFoundErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetWithdrawableMargin(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetRequiredMarginForOrder(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	accountID, _ := new(big.Int).SetString("170141183460469231731687303715884105754", 10)
+	// max uint128 value is never reached by the perps account id counter
+	notExistingID, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	testCases := []struct {
+		name      string
+		accountID *big.Int
+		marketID  *big.Int
+		sizeDelta *big.Int
+		wantErr   error
+	}{
+		{
+			name:      "nil account id",
+			marketID:  big.NewInt(100),
+			sizeDelta: big.NewInt(1),
+			wantErr:   errors.InvalidArgumentErr,
+		},
+		{
+			name:      "nil size delta",
+			accountID: accountID,
+			marketID:  big.NewInt(100),
+			wantErr:   errors.InvalidArgumentErr,
+		},
+		{
+			name:      "not existing account",
+			accountID: notExistingID,
+			marketID:  big.NewInt(100),
+			sizeDelta: big.NewInt(1000000000000000),
+			wantErr:   errors.AccountNotFoundErr,
+		},
+		{
+			name:      "long",
+			accountID: accountID,
+			marketID:  big.NewInt(100),
+			sizeDelta: big.NewInt(1000000000000000),
+		},
+		{
+			name:      "short",
+			accountID: accountID,
+			marketID:  big.NewInt(100),
+			sizeDelta: big.NewInt(-1000000000000000),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetRequiredMarginForOrder(tt.accountID, tt.marketID, tt.sizeDelta)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetAccountLastInteraction(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	accountID, _ := new(big.Int).SetString("170141183460469231731687303715884105754", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "existing account",
+			id:   accountID,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetAccountLastInteraction(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.LessOrEqual(t, res, uint64(time.Now().Unix()))
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetAccountOwner(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	accountID, _ := new(big.Int).SetString("170141183460469231731687303715884105754", 10)
+	// max uint128 value is never reached by the perps account id counter
+	notExistingID, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:    "not existing account",
+			id:      notExistingID,
+			wantErr: errors.AccountNotFoundErr,
+		},
+		{
+			name: "existing account",
+			id:   accountID,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetAccountOwner(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.True(t, common.IsHexAddress(res))
+				require.Equal(t, common.HexToAddress(res).Hex(), res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_HasPermission(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	accountID, _ := new(big.Int).SetString("170141183460469231731687303715884105754", 10)
+
+	testCases := []struct {
+		name       string
+		id         *big.Int
+		user       string
+		permission string
+		wantErr    error
+	}{
+		{
+			name:       "nil id",
+			user:       "0x0000000000000000000000000000000000000001",
+			permission: "ADMIN",
+			wantErr:    errors.InvalidArgumentErr,
+		},
+		{
+			name:       "invalid user",
+			id:         accountID,
+			user:       "not an address",
+			permission: "ADMIN",
+			wantErr:    errors.InvalidArgumentErr,
+		},
+		{
+			name:       "unsupported permission",
+			id:         accountID,
+			user:       "0x0000000000000000000000000000000000000001",
+			permission: "SUPER_ADMIN",
+			wantErr:    errors.EnumUnsupportedErr,
+		},
+		{
+			name:       "not granted",
+			id:         accountID,
+			user:       "0x0000000000000000000000000000000000000001",
+			permission: "PERPS_COMMIT_ASYNC_ORDER",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.HasPermission(tt.id, tt.user, tt.permission)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.False(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetCollateralAmount(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	id := new(big.Int)
+	id.SetString("170141183460469231731687303715884105754", 10)
+
+	testCases := []struct {
+		name     string
+		id       *big.Int
+		marketID *big.Int
+		wantErr  error
+	}{
+		{
+			name:     "nil account id",
+			marketID: big.NewInt(0),
+			wantErr:  errors.InvalidArgumentErr,
+		},
+		{
+			name:    "nil market id",
+			id:      id,
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:     "sUSD collateral",
+			id:       id,
+			marketID: big.NewInt(0),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetCollateralAmount(tt.id, tt.marketID)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetAccountCollaterals(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	id := new(big.Int)
+	id.SetString("170141183460469231731687303715884105754", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil account id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "account",
+			id:   id,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			ids, err := s.GetAccountCollateralIds(tt.id)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				_, err = s.GetAccountCollaterals(tt.id)
+				require.ErrorIs(t, err, tt.wantErr)
+
+				_, err = s.GetTotalCollateralValue(tt.id)
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			collaterals, err := s.GetAccountCollaterals(tt.id)
+			require.NoError(t, err)
+			require.Len(t, collaterals, len(ids))
+
+			for i, c := range collaterals {
+				require.Equal(t, ids[i], c.SynthMarketID)
+				require.NotNil(t, c.Amount)
+			}
+		})
+	}
+}
+
+func TestService_AccountNotFound_RecordedCall(t *testing.T) {
+	notFound := &revertErr{data: "0x0e296c98000000000000000000000000000000000000000000000000000000000000007b"}
+
+	caller, err := perpsMarket.NewPerpsMarketCaller(
+		common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+		&countingCaller{err: notFound},
+	)
+	require.NoError(t, err)
+
+	s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller}}
+
+	_, err = s.GetAccountOwner(big.NewInt(123))
+	require.ErrorIs(t, err, errors.AccountNotFoundErr)
+	require.ErrorIs(t, err, errors.NotFoundErr)
+
+	_, err = s.GetWithdrawableMargin(big.NewInt(123))
+	require.ErrorIs(t, err, errors.AccountNotFoundErr)
+
+	_, err = s.GetRequiredMarginForOrder(big.NewInt(123), big.NewInt(100), big.NewInt(1))
+	require.ErrorIs(t, err, errors.AccountNotFoundErr)
+}
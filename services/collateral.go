@@ -4,10 +4,12 @@ import (
 	"context"
 	"github.com/ethereum/go-ethereum/common"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
@@ -27,8 +29,13 @@ func (s *Service) GetCollateralPrice(blockNumber *big.Int, collateralType common
 	return &models.CollateralPrice{Price: price}, nil
 }
 
+func (s *Service) RetrieveCollateralWithdrawn(fromBlock uint64, toBLock *uint64) ([]*models.CollateralWithdrawn, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveCollateralWithdrawn(opts)
+}
+
 func (s *Service) RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +115,13 @@ func (s *Service) getCollateralWithdrawn(event *core.CoreWithdrawn, blockN uint6
 	return models.GetCollateralWithdrawnFromEvent(event, block.Time), nil
 }
 
+func (s *Service) RetrieveCollateralDeposited(fromBlock uint64, toBLock *uint64) ([]*models.CollateralDeposited, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveCollateralDeposited(opts)
+}
+
 func (s *Service) RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -188,3 +200,466 @@ func (s *Service) getCollateralDeposited(event *core.CoreDeposited, blockN uint6
 
 	return models.GetCollateralDepositedFromEvent(event, block.Time), nil
 }
+
+func (s *Service) RetrieveCollateralModified(fromBlock uint64, toBLock *uint64) ([]*models.CollateralModified, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveCollateralModified(opts)
+}
+
+func (s *Service) RetrieveCollateralModifiedLimit(limit uint64) ([]*models.CollateralModified, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var modifications []*models.CollateralModified
+
+	logger.Log().WithField("layer", "Service-RetrieveCollateralModifiedLimit").Infof(
+		"fetching collateral modifications with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralModifiedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveCollateralModified(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		modifications = append(modifications, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveCollateralModifiedLimit").Infof("task completed successfully")
+
+	return modifications, nil
+}
+
+// retrieveCollateralModified is used to retrieve collateral modifications with given filter options
+func (s *Service) retrieveCollateralModified(opts *bind.FilterOpts) ([]*models.CollateralModified, error) {
+	iterator, err := s.perpsMarket.FilterCollateralModified(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCollateralModified").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var modifications []*models.CollateralModified
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralModified").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		modification, err := s.getCollateralModified(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		modifications = append(modifications, modification)
+	}
+
+	return modifications, nil
+}
+
+// getCollateralModified is used to get models.CollateralModified from given event and block number
+func (s *Service) getCollateralModified(event *perpsMarket.PerpsMarketCollateralModified, blockN uint64) (*models.CollateralModified, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCollateralModified").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetCollateralModifiedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) GetSupportedCollaterals() ([]*models.PerpsCollateralConfig, error) {
+	ids, err := s.perpsMarket.GetSupportedCollaterals(nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetSupportedCollaterals").Errorf("get supported collaterals error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "GetSupportedCollaterals")
+	}
+
+	configs := []*models.PerpsCollateralConfig{}
+	for _, id := range ids {
+		maxAmount, err := s.perpsMarket.GetCollateralConfiguration(nil, id)
+		if err != nil {
+			logger.Log().WithField("layer", "Service-GetSupportedCollaterals").Errorf(
+				"get collateral configuration for synth market %v error: %v", id.String(), err.Error(),
+			)
+			return nil, errors.GetReadContractErr(err, "perps market", "GetCollateralConfiguration")
+		}
+
+		configs = append(configs, models.GetPerpsCollateralConfig(id, maxAmount))
+	}
+
+	return configs, nil
+}
+
+func (s *Service) RetrievePerpsCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.PerpsCollateralConfig, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrievePerpsCollateralConfigured(opts)
+}
+
+func (s *Service) RetrievePerpsCollateralConfiguredLimit(limit uint64) ([]*models.PerpsCollateralConfig, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []*models.PerpsCollateralConfig
+
+	logger.Log().WithField("layer", "Service-RetrievePerpsCollateralConfiguredLimit").Infof(
+		"fetching perps collateral configurations with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePerpsCollateralConfiguredLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrievePerpsCollateralConfigured(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePerpsCollateralConfiguredLimit").Infof("task completed successfully")
+
+	return configs, nil
+}
+
+// retrievePerpsCollateralConfigured is used to retrieve perps collateral configurations with given filter options
+func (s *Service) retrievePerpsCollateralConfigured(opts *bind.FilterOpts) ([]*models.PerpsCollateralConfig, error) {
+	iterator, err := s.perpsMarket.FilterCollateralConfigurationSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePerpsCollateralConfigured").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var configs []*models.PerpsCollateralConfig
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePerpsCollateralConfigured").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		config, err := s.getPerpsCollateralConfig(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// getPerpsCollateralConfig is used to get models.PerpsCollateralConfig from given event and block number
+func (s *Service) getPerpsCollateralConfig(event *perpsMarket.PerpsMarketCollateralConfigurationSet, blockN uint64) (*models.PerpsCollateralConfig, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePerpsCollateralConfigured").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetPerpsCollateralConfigFromEvent(event, block.Time), nil
+}
+
+func (s *Service) GetAccountAvailableCollateral(accountID *big.Int, collateralType common.Address) (*big.Int, error) {
+	if accountID == nil {
+		logger.Log().WithField("layer", "Service-GetAccountAvailableCollateral").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	res, err := s.core.GetAccountAvailableCollateral(nil, accountID, collateralType)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetAccountAvailableCollateral").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getAccountAvailableCollateral")
+	}
+
+	return res, nil
+}
+
+func (s *Service) GetCollateralConfiguration(collateralType common.Address) (*models.CollateralConfiguration, error) {
+	config, err := s.core.GetCollateralConfiguration(nil, collateralType)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetCollateralConfiguration").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getCollateralConfiguration")
+	}
+
+	// contract returns blank configuration for not configured collateral types instead of reverting
+	if config.TokenAddress == (common.Address{}) {
+		logger.Log().WithField("layer", "Service-GetCollateralConfiguration").Errorf("collateral %v not configured", collateralType.Hex())
+		return nil, errors.GetNotFoundErr("collateral configuration")
+	}
+
+	return models.GetCollateralConfigurationFromContract(config), nil
+}
+
+func (s *Service) GetCollateralConfigurations(hideDisabled bool) ([]*models.CollateralConfiguration, error) {
+	configs, err := s.core.GetCollateralConfigurations(nil, hideDisabled)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetCollateralConfigurations").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getCollateralConfigurations")
+	}
+
+	res := make([]*models.CollateralConfiguration, 0, len(configs))
+	for _, config := range configs {
+		res = append(res, models.GetCollateralConfigurationFromContract(config))
+	}
+
+	return res, nil
+}
+
+func (s *Service) RetrieveCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.CollateralConfiguration, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveCollateralConfigured(opts)
+}
+
+func (s *Service) RetrieveCollateralConfiguredLimit(limit uint64) ([]*models.CollateralConfiguration, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []*models.CollateralConfiguration
+
+	logger.Log().WithField("layer", "Service-RetrieveCollateralConfiguredLimit").Infof(
+		"fetching collateral configurations with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralConfiguredLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveCollateralConfigured(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveCollateralConfiguredLimit").Infof("task completed successfully")
+
+	return configs, nil
+}
+
+// retrieveCollateralConfigured is used to retrieve collateral configurations with given filter options
+func (s *Service) retrieveCollateralConfigured(opts *bind.FilterOpts) ([]*models.CollateralConfiguration, error) {
+	iterator, err := s.core.FilterCollateralConfigured(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCollateralConfigured").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var configs []*models.CollateralConfiguration
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralConfigured").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		config, err := s.getCollateralConfiguration(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+// getCollateralConfiguration is used to get models.CollateralConfiguration from given event and block number
+func (s *Service) getCollateralConfiguration(event *core.CoreCollateralConfigured, blockN uint64) (*models.CollateralConfiguration, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCollateralConfigured").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetCollateralConfigurationFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveCollateralLockChanges(fromBlock uint64, toBLock *uint64) ([]*models.CollateralLock, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveCollateralLockChanges(opts)
+}
+
+func (s *Service) RetrieveCollateralLockChangesLimit(limit uint64) ([]*models.CollateralLock, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*models.CollateralLock{}
+
+	logger.Log().WithField("layer", "Service-RetrieveCollateralLockChangesLimit").Infof(
+		"fetching collateral lock changes with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralLockChangesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveCollateralLockChanges(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveCollateralLockChangesLimit").Infof("task completed successfully")
+
+	return changes, nil
+}
+
+// retrieveCollateralLockChanges is used to retrieve "CollateralLockCreated" and "CollateralLockExpired" events with
+// given filter options merged to one stream ordered by block number and log index
+func (s *Service) retrieveCollateralLockChanges(opts *bind.FilterOpts) ([]*models.CollateralLock, error) {
+	changes := []*models.CollateralLock{}
+
+	created, err := s.retrieveCollateralLocksCreated(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, created...)
+
+	expired, err := s.retrieveCollateralLocksExpired(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, expired...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].BlockNumber != changes[j].BlockNumber {
+			return changes[i].BlockNumber < changes[j].BlockNumber
+		}
+
+		return changes[i].LogIndex < changes[j].LogIndex
+	})
+
+	return changes, nil
+}
+
+// retrieveCollateralLocksCreated is used to retrieve "CollateralLockCreated" events as collateral lock changes with
+// given filter options
+func (s *Service) retrieveCollateralLocksCreated(opts *bind.FilterOpts) ([]*models.CollateralLock, error) {
+	iterator, err := s.core.FilterCollateralLockCreated(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCollateralLockChanges").Errorf("error get created iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.CollateralLock
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralLockChanges").Errorf("created iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetCollateralLockFromCreatedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrieveCollateralLocksExpired is used to retrieve "CollateralLockExpired" events as collateral lock changes with
+// given filter options
+func (s *Service) retrieveCollateralLocksExpired(opts *bind.FilterOpts) ([]*models.CollateralLock, error) {
+	iterator, err := s.core.FilterCollateralLockExpired(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCollateralLockChanges").Errorf("error get expired iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.CollateralLock
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveCollateralLockChanges").Errorf("expired iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetCollateralLockFromExpiredEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
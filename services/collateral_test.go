@@ -0,0 +1,313 @@
+package services
+
+import (
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+)
+
+func TestService_RetrieveCollateralConfigured_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveCollateralConfiguredLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveCollateralLockChanges_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveCollateralLockChangesLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_GetSupportedCollaterals_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	configs, err := s.GetSupportedCollaterals()
+
+	require.NoError(t, err)
+	require.NotEmpty(t, configs)
+	for _, c := range configs {
+		require.NotNil(t, c.MaxCollateralAmount)
+		require.Equal(t, c.MaxCollateralAmount.Sign() == 0, c.Disabled)
+	}
+}
+
+func TestService_GetCollateralConfiguration_RecordedCall(t *testing.T) {
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	snx := common.HexToAddress("0x22e6966B799c4D5B13BE962E1D117b56327FDa66")
+
+	enabled := core.CollateralConfigurationData{
+		DepositingEnabled:    true,
+		IssuanceRatioD18:     big.NewInt(5000000000000000000),
+		LiquidationRatioD18:  big.NewInt(1500000000000000000),
+		LiquidationRewardD18: big.NewInt(1000000000000000000),
+		OracleNodeId:         common.HexToHash("0x01"),
+		TokenAddress:         snx,
+		MinDelegationD18:     big.NewInt(100000000000000000),
+	}
+	blank := core.CollateralConfigurationData{
+		IssuanceRatioD18:     big.NewInt(0),
+		LiquidationRatioD18:  big.NewInt(0),
+		LiquidationRewardD18: big.NewInt(0),
+		MinDelegationD18:     big.NewInt(0),
+	}
+
+	testCases := []struct {
+		name    string
+		config  core.CollateralConfigurationData
+		wantErr error
+	}{
+		{
+			name:   "configured collateral",
+			config: enabled,
+		},
+		{
+			name:    "not configured collateral",
+			config:  blank,
+			wantErr: errors.NotFoundErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := coreABI.Methods["getCollateralConfiguration"].Outputs.Pack(tt.config)
+			require.NoError(t, err)
+
+			caller, err := core.NewCoreCaller(
+				common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				&recordedCaller{data: data},
+			)
+			require.NoError(t, err)
+
+			s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+			res, err := s.GetCollateralConfiguration(snx)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, snx, res.CollateralType)
+				require.True(t, res.DepositingEnabled)
+				require.Equal(t, "1500000000000000000", res.LiquidationRatioD18.String())
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetCollateralConfigurations_RecordedCall(t *testing.T) {
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	data, err := coreABI.Methods["getCollateralConfigurations"].Outputs.Pack([]core.CollateralConfigurationData{})
+	require.NoError(t, err)
+
+	caller, err := core.NewCoreCaller(
+		common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+	res, err := s.GetCollateralConfigurations(true)
+
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Empty(t, res)
+}
+
+func TestService_GetCollateralConfigurations_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	all, err := s.GetCollateralConfigurations(false)
+	require.NoError(t, err)
+
+	enabled, err := s.GetCollateralConfigurations(true)
+	require.NoError(t, err)
+
+	require.LessOrEqual(t, len(enabled), len(all))
+	for _, c := range enabled {
+		require.True(t, c.DepositingEnabled)
+	}
+}
+
+func TestService_GetAccountAvailableCollateral_RecordedCall(t *testing.T) {
+	deposited, _ := new(big.Int).SetString("1250000000000000000000", 10)
+
+	testCases := []struct {
+		name      string
+		accountID *big.Int
+		data      []byte
+		want      string
+		wantErr   error
+	}{
+		{
+			name:    "nil account id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:      "deposited collateral",
+			accountID: big.NewInt(1),
+			data:      common.LeftPadBytes(deposited.Bytes(), 32),
+			want:      "1250000000000000000000",
+		},
+		{
+			name:      "never deposited collateral",
+			accountID: big.NewInt(1),
+			data:      make([]byte, 32),
+			want:      "0",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := core.NewCoreCaller(
+				common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				&recordedCaller{data: tt.data},
+			)
+			require.NoError(t, err)
+
+			s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+			res, err := s.GetAccountAvailableCollateral(tt.accountID, common.HexToAddress("0x22e6966B799c4D5B13BE962E1D117b56327FDa66"))
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, res.String())
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetAccountAvailableCollateral_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	// token never deposited to the core
+	res, err := s.GetAccountAvailableCollateral(big.NewInt(1), common.HexToAddress("0x000000000000000000000000000000000000dEaD"))
+
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Sign())
+}
+
+func TestService_RetrievePerpsCollateralConfigured_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	fromBlock := conf.FirstContractBlocks.PerpsMarket
+	toBlock := fromBlock + 20000
+
+	res, err := s.RetrievePerpsCollateralConfigured(fromBlock, &toBlock)
+
+	require.NoError(t, err)
+	for _, item := range res {
+		require.GreaterOrEqual(t, item.BlockNumber, fromBlock)
+		require.LessOrEqual(t, item.BlockNumber, toBlock)
+	}
+}
+
+func TestService_RetrievePerpsCollateralConfigured_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePerpsCollateralConfiguredLimit(20000)
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetKeeperRewardGuards() (*models.KeeperRewardGuards, error) {
+	resp, err := s.perpsMarket.GetKeeperRewardGuards(nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetKeeperRewardGuards").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perpsMarket", "getKeeperRewardGuards")
+	}
+
+	return models.GetKeeperRewardGuards(resp), nil
+}
+
+func (s *Service) RetrieveKeeperRewardGuardsSet(fromBlock uint64, toBLock *uint64) ([]*models.KeeperRewardGuards, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveKeeperRewardGuardsSet(opts)
+}
+
+func (s *Service) RetrieveKeeperRewardGuardsSetLimit(limit uint64) ([]*models.KeeperRewardGuards, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var guards []*models.KeeperRewardGuards
+
+	logger.Log().WithField("layer", "Service-RetrieveKeeperRewardGuardsSetLimit").Infof(
+		"fetching keeper reward guards with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveKeeperRewardGuardsSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveKeeperRewardGuardsSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		guards = append(guards, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveKeeperRewardGuardsSetLimit").Infof("task completed successfully")
+
+	return guards, nil
+}
+
+// retrieveKeeperRewardGuardsSet is used to retrieve keeper reward guards with given filter options
+func (s *Service) retrieveKeeperRewardGuardsSet(opts *bind.FilterOpts) ([]*models.KeeperRewardGuards, error) {
+	iterator, err := s.perpsMarket.FilterKeeperRewardGuardsSet(opts)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveKeeperRewardGuardsSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var guards []*models.KeeperRewardGuards
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveKeeperRewardGuardsSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		guard, err := s.getKeeperRewardGuardsSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		guards = append(guards, guard)
+	}
+
+	return guards, nil
+}
+
+// getKeeperRewardGuardsSet is used to get models.KeeperRewardGuards from given event and block number
+func (s *Service) getKeeperRewardGuardsSet(event *perpsMarket.PerpsMarketKeeperRewardGuardsSet, blockN uint64) (*models.KeeperRewardGuards, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveKeeperRewardGuardsSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetKeeperRewardGuardsFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrievePerAccountCapsSet(fromBlock uint64, toBLock *uint64) ([]*models.PerAccountCaps, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrievePerAccountCapsSet(opts)
+}
+
+func (s *Service) RetrievePerAccountCapsSetLimit(limit uint64) ([]*models.PerAccountCaps, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var caps []*models.PerAccountCaps
+
+	logger.Log().WithField("layer", "Service-RetrievePerAccountCapsSetLimit").Infof(
+		"fetching per account caps with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePerAccountCapsSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrievePerAccountCapsSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		caps = append(caps, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePerAccountCapsSetLimit").Infof("task completed successfully")
+
+	return caps, nil
+}
+
+// retrievePerAccountCapsSet is used to retrieve per account caps with given filter options
+func (s *Service) retrievePerAccountCapsSet(opts *bind.FilterOpts) ([]*models.PerAccountCaps, error) {
+	iterator, err := s.perpsMarket.FilterPerAccountCapsSet(opts)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePerAccountCapsSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var caps []*models.PerAccountCaps
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePerAccountCapsSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		accountCaps, err := s.getPerAccountCapsSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		caps = append(caps, accountCaps)
+	}
+
+	return caps, nil
+}
+
+// getPerAccountCapsSet is used to get models.PerAccountCaps from given event and block number
+func (s *Service) getPerAccountCapsSet(event *perpsMarket.PerpsMarketPerAccountCapsSet, blockN uint64) (*models.PerAccountCaps, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePerAccountCapsSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetPerAccountCapsFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveFeeCollectorSet(fromBlock uint64, toBLock *uint64) ([]*models.FeeCollector, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveFeeCollectorSet(opts)
+}
+
+func (s *Service) RetrieveFeeCollectorSetLimit(limit uint64) ([]*models.FeeCollector, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var collectors []*models.FeeCollector
+
+	logger.Log().WithField("layer", "Service-RetrieveFeeCollectorSetLimit").Infof(
+		"fetching fee collectors with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveFeeCollectorSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveFeeCollectorSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		collectors = append(collectors, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveFeeCollectorSetLimit").Infof("task completed successfully")
+
+	return collectors, nil
+}
+
+// retrieveFeeCollectorSet is used to retrieve fee collectors with given filter options
+func (s *Service) retrieveFeeCollectorSet(opts *bind.FilterOpts) ([]*models.FeeCollector, error) {
+	iterator, err := s.perpsMarket.FilterFeeCollectorSet(opts)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveFeeCollectorSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var collectors []*models.FeeCollector
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveFeeCollectorSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		collector, err := s.getFeeCollectorSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		collectors = append(collectors, collector)
+	}
+
+	return collectors, nil
+}
+
+// getFeeCollectorSet is used to get models.FeeCollector from given event and block number
+func (s *Service) getFeeCollectorSet(event *perpsMarket.PerpsMarketFeeCollectorSet, blockN uint64) (*models.FeeCollector, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveFeeCollectorSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetFeeCollectorFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveReferrerSharesUpdated(fromBlock uint64, toBLock *uint64) ([]*models.ReferrerShare, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveReferrerSharesUpdated(opts)
+}
+
+func (s *Service) RetrieveReferrerSharesUpdatedLimit(limit uint64) ([]*models.ReferrerShare, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var shares []*models.ReferrerShare
+
+	logger.Log().WithField("layer", "Service-RetrieveReferrerSharesUpdatedLimit").Infof(
+		"fetching referrer shares with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveReferrerSharesUpdatedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveReferrerSharesUpdated(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveReferrerSharesUpdatedLimit").Infof("task completed successfully")
+
+	return shares, nil
+}
+
+// retrieveReferrerSharesUpdated is used to retrieve referrer shares with given filter options
+func (s *Service) retrieveReferrerSharesUpdated(opts *bind.FilterOpts) ([]*models.ReferrerShare, error) {
+	iterator, err := s.perpsMarket.FilterReferrerShareUpdated(opts)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveReferrerSharesUpdated").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var shares []*models.ReferrerShare
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveReferrerSharesUpdated").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		share, err := s.getReferrerShare(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
+
+// getReferrerShare is used to get models.ReferrerShare from given event and block number
+func (s *Service) getReferrerShare(event *perpsMarket.PerpsMarketReferrerShareUpdated, blockN uint64) (*models.ReferrerShare, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveReferrerSharesUpdated").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetReferrerShareFromEvent(event, block.Time), nil
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/models"
+)
+
+func TestService_GetKeeperRewardGuards_RecordedCall(t *testing.T) {
+	// getKeeperRewardGuards return data fixture: 1 USD min reward, 0.3 min profit ratio, 50 USD max reward and
+	// 1 max scaling ratio
+	data := common.FromHex("0x" +
+		"0000000000000000000000000000000000000000000000000de0b6b3a7640000" +
+		"0000000000000000000000000000000000000000000000000429d069189e0000" +
+		"000000000000000000000000000000000000000000000002b5e3af16b1880000" +
+		"0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+	)
+
+	caller, err := perpsMarket.NewPerpsMarketCaller(
+		common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller}}
+
+	maxReward, _ := new(big.Int).SetString("50000000000000000000", 10)
+
+	res, err := s.GetKeeperRewardGuards()
+
+	require.NoError(t, err)
+	require.Equal(t, &models.KeeperRewardGuards{
+		MinKeeperRewardUsd:       big.NewInt(1000000000000000000),
+		MinKeeperProfitRatioD18:  big.NewInt(300000000000000000),
+		MaxKeeperRewardUsd:       maxReward,
+		MaxKeeperScalingRatioD18: big.NewInt(1000000000000000000),
+	}, res)
+}
+
+func TestService_RetrieveKeeperRewardGuardsSet_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	fromBlock := conf.FirstContractBlocks.PerpsMarket
+	toBlock := fromBlock + 20000
+
+	res, err := s.RetrieveKeeperRewardGuardsSet(fromBlock, &toBlock)
+
+	require.NoError(t, err)
+	for _, item := range res {
+		require.GreaterOrEqual(t, item.BlockNumber, fromBlock)
+		require.LessOrEqual(t, item.BlockNumber, toBlock)
+	}
+}
+
+func TestService_RetrieveKeeperRewardGuardsSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveKeeperRewardGuardsSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrievePerAccountCapsSet_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	fromBlock := conf.FirstContractBlocks.PerpsMarket
+	toBlock := fromBlock + 20000
+
+	res, err := s.RetrievePerAccountCapsSet(fromBlock, &toBlock)
+
+	require.NoError(t, err)
+	for _, item := range res {
+		require.GreaterOrEqual(t, item.BlockNumber, fromBlock)
+		require.LessOrEqual(t, item.BlockNumber, toBlock)
+	}
+}
+
+func TestService_RetrievePerAccountCapsSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePerAccountCapsSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveFeeCollectorSet_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	fromBlock := conf.FirstContractBlocks.PerpsMarket
+	toBlock := fromBlock + 20000
+
+	res, err := s.RetrieveFeeCollectorSet(fromBlock, &toBlock)
+
+	require.NoError(t, err)
+	for _, item := range res {
+		require.GreaterOrEqual(t, item.BlockNumber, fromBlock)
+		require.LessOrEqual(t, item.BlockNumber, toBlock)
+	}
+}
+
+func TestService_RetrieveFeeCollectorSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveFeeCollectorSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveReferrerSharesUpdated_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	fromBlock := conf.FirstContractBlocks.PerpsMarket
+	toBlock := fromBlock + 20000
+
+	res, err := s.RetrieveReferrerSharesUpdated(fromBlock, &toBlock)
+
+	require.NoError(t, err)
+	for _, item := range res {
+		require.GreaterOrEqual(t, item.BlockNumber, fromBlock)
+		require.LessOrEqual(t, item.BlockNumber, toBlock)
+	}
+}
+
+func TestService_RetrieveReferrerSharesUpdated_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveReferrerSharesUpdatedLimit(20000)
+
+	require.NoError(t, err)
+}
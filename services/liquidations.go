@@ -2,16 +2,89 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
 
+func (s *Service) CanLiquidate(accountId *big.Int) (bool, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-CanLiquidate").Errorf("received nil account id")
+		return false, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	if s.isPerpsMultiCall() {
+		return s.canLiquidateMultiCall(accountId)
+	}
+
+	res, err := s.perpsMarket.CanLiquidate(nil, accountId)
+	if err != nil {
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-CanLiquidate").Errorf("oracle data required")
+			return false, errors.GetOracleDataRequiredErr(err, "perps market", "CanLiquidate")
+		}
+
+		logger.Log().WithField("layer", "Service-CanLiquidate").Errorf("can liquidate error: %v", err.Error())
+		return false, errors.GetReadContractErr(err, "perps market", "CanLiquidate")
+	}
+
+	return res, nil
+}
+
+// canLiquidateMultiCall is used to check if given account ID can be liquidated with perps multicall retries. Oracle
+// queries are fulfilled for all perps markets as account positions can be opened in any of them
+func (s *Service) canLiquidateMultiCall(accountId *big.Int) (bool, error) {
+	callData, err := s.rawPerpsContract.GetCallDataCanLiquidate(accountId)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := s.perpsMultiCallRetries(callData, nil, 0)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-CanLiquidate").Errorf("can liquidate multicall error: %v", err.Error())
+		return false, err
+	}
+
+	return s.rawPerpsContract.UnpackCanLiquidate(res)
+}
+
+func (s *Service) CanLiquidateAccounts(accountIds []*big.Int) ([]*models.LiquidationCheck, error) {
+	res := make([]*models.LiquidationCheck, len(accountIds))
+
+	errs := runBatch(len(accountIds), func(i int) error {
+		canLiquidate, err := s.CanLiquidate(accountIds[i])
+		if err != nil {
+			err = fmt.Errorf("account %v: %w", accountIds[i], err)
+		}
+
+		res[i] = &models.LiquidationCheck{AccountID: accountIds[i], CanLiquidate: canLiquidate, Err: err}
+		return err
+	})
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+
+	if len(failed) != 0 {
+		logger.Log().WithField("layer", "Service-CanLiquidateAccounts").Errorf(
+			"%v of %v accounts checks failed", len(failed), len(accountIds),
+		)
+		return res, errors.GetBatchErr(failed)
+	}
+
+	return res, nil
+}
+
 func (s *Service) RetrieveLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.Liquidation, error) {
 	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
 	return s.retrieveLiquidations(opts)
@@ -97,3 +170,264 @@ func (s *Service) getLiquidation(event *perpsMarket.PerpsMarketPositionLiquidate
 
 	return models.GetLiquidationFromEvent(event, block.Time), nil
 }
+
+func (s *Service) RetrieveAccountLiquidationAttempts(fromBlock uint64, toBLock *uint64) ([]*models.AccountLiquidationAttempt, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveAccountLiquidationAttempts(opts)
+}
+
+func (s *Service) RetrieveAccountLiquidationAttemptsLimit(limit uint64) ([]*models.AccountLiquidationAttempt, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var attempts []*models.AccountLiquidationAttempt
+
+	logger.Log().WithField("layer", "Service-RetrieveAccountLiquidationAttemptsLimit").Infof(
+		"fetching account liquidation attempts with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveAccountLiquidationAttemptsLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveAccountLiquidationAttempts(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveAccountLiquidationAttemptsLimit").Infof("task completed successfully")
+
+	return attempts, nil
+}
+
+// retrieveAccountLiquidationAttempts is used to retrieve account liquidation attempts with given filter options
+func (s *Service) retrieveAccountLiquidationAttempts(opts *bind.FilterOpts) ([]*models.AccountLiquidationAttempt, error) {
+	iterator, err := s.perpsMarket.FilterAccountLiquidationAttempt(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveAccountLiquidationAttempts").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var attempts []*models.AccountLiquidationAttempt
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveAccountLiquidationAttempts").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		attempt, err := s.getAccountLiquidationAttempts(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, nil
+}
+
+// getAccountLiquidationAttempts is used to get models.AccountLiquidationAttempt from given event and block number
+func (s *Service) getAccountLiquidationAttempts(event *perpsMarket.PerpsMarketAccountLiquidationAttempt, blockN uint64) (*models.AccountLiquidationAttempt, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveAccountLiquidationAttempts").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetAccountLiquidationAttemptFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveCoreLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.CoreLiquidation, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveCoreLiquidations(opts)
+}
+
+func (s *Service) RetrieveCoreLiquidationsLimit(limit uint64) ([]*models.CoreLiquidation, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var liquidations []*models.CoreLiquidation
+
+	logger.Log().WithField("layer", "Service-RetrieveCoreLiquidationsLimit").Infof(
+		"fetching core LP position liquidations with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveCoreLiquidationsLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveCoreLiquidations(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		liquidations = append(liquidations, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveCoreLiquidationsLimit").Infof("task completed successfully")
+
+	return liquidations, nil
+}
+
+// retrieveCoreLiquidations is used to retrieve core LP position liquidations with given filter options
+func (s *Service) retrieveCoreLiquidations(opts *bind.FilterOpts) ([]*models.CoreLiquidation, error) {
+	iterator, err := s.core.FilterLiquidation(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCoreLiquidations").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var liquidations []*models.CoreLiquidation
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveCoreLiquidations").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		liquidation, err := s.getCoreLiquidation(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		liquidations = append(liquidations, liquidation)
+	}
+
+	return liquidations, nil
+}
+
+// getCoreLiquidation is used to get models.CoreLiquidation from given event and block number
+func (s *Service) getCoreLiquidation(event *core.CoreLiquidation, blockN uint64) (*models.CoreLiquidation, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveCoreLiquidations").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetCoreLiquidationFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveVaultLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.VaultLiquidation, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveVaultLiquidations(opts)
+}
+
+func (s *Service) RetrieveVaultLiquidationsLimit(limit uint64) ([]*models.VaultLiquidation, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var liquidations []*models.VaultLiquidation
+
+	logger.Log().WithField("layer", "Service-RetrieveVaultLiquidationsLimit").Infof(
+		"fetching vault liquidations with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveVaultLiquidationsLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveVaultLiquidations(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		liquidations = append(liquidations, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveVaultLiquidationsLimit").Infof("task completed successfully")
+
+	return liquidations, nil
+}
+
+// retrieveVaultLiquidations is used to retrieve vault liquidations with given filter options
+func (s *Service) retrieveVaultLiquidations(opts *bind.FilterOpts) ([]*models.VaultLiquidation, error) {
+	iterator, err := s.core.FilterVaultLiquidation(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveVaultLiquidations").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var liquidations []*models.VaultLiquidation
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveVaultLiquidations").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		liquidation, err := s.getVaultLiquidation(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		liquidations = append(liquidations, liquidation)
+	}
+
+	return liquidations, nil
+}
+
+// getVaultLiquidation is used to get models.VaultLiquidation from given event and block number
+func (s *Service) getVaultLiquidation(event *core.CoreVaultLiquidation, blockN uint64) (*models.VaultLiquidation, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveVaultLiquidations").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetVaultLiquidationFromEvent(event, block.Time), nil
+}
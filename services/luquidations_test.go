@@ -1,6 +1,7 @@
 package services
 
 import (
+	"fmt"
 	"github.com/gateway-fm/perpsv3-Go/config"
 	"log"
 	"math/big"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 )
 
@@ -29,7 +31,7 @@ func TestService_RetrieveLiquidations_OnChain_Limit(t *testing.T) {
 	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
 	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
 
-	s, _ := NewService(rpcClient, conf, coreC, perps)
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 	_, err := s.RetrieveLiquidationsLimit(20000)
 
@@ -84,7 +86,7 @@ func TestService_RetrieveLiquidations_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 			res, err := s.RetrieveLiquidations(tt.startBlock, &tt.endBlock)
 
 			if err != nil {
@@ -110,3 +112,142 @@ func TestService_RetrieveLiquidations_OnChain(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RetrieveAccountLiquidationAttempts_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveAccountLiquidationAttemptsLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveCoreLiquidations_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveCoreLiquidationsLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveVaultLiquidations_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveVaultLiquidationsLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_CanLiquidateAccounts_RecordedCall(t *testing.T) {
+	// canLiquidate return data fixture for the liquidatable account
+	data := common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+
+	caller, err := perpsMarket.NewPerpsMarketCaller(
+		common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller}}
+
+	t.Run("all accounts checked", func(t *testing.T) {
+		ids := make([]*big.Int, 25)
+		for i := range ids {
+			ids[i] = big.NewInt(int64(i + 1))
+		}
+
+		res, err := s.CanLiquidateAccounts(ids)
+
+		require.NoError(t, err)
+		require.Len(t, res, len(ids))
+		for i, r := range res {
+			require.Equal(t, ids[i], r.AccountID)
+			require.True(t, r.CanLiquidate)
+			require.NoError(t, r.Err)
+		}
+	})
+
+	t.Run("failed account does not abort the batch", func(t *testing.T) {
+		res, err := s.CanLiquidateAccounts([]*big.Int{big.NewInt(1), nil, big.NewInt(3)})
+
+		require.ErrorIs(t, err, errors.BatchErr)
+		require.ErrorIs(t, err, errors.InvalidArgumentErr)
+		require.Len(t, res, 3)
+
+		require.True(t, res[0].CanLiquidate)
+		require.NoError(t, res[0].Err)
+
+		require.False(t, res[1].CanLiquidate)
+		require.ErrorIs(t, res[1].Err, errors.InvalidArgumentErr)
+
+		require.Equal(t, big.NewInt(3), res[2].AccountID)
+		require.True(t, res[2].CanLiquidate)
+		require.NoError(t, res[2].Err)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		res, err := s.CanLiquidateAccounts(nil)
+
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+}
+
+func TestRunBatch(t *testing.T) {
+	n := 50
+	res := make([]int, n)
+
+	errs := runBatch(n, func(i int) error {
+		res[i] = i * 2
+		if i%10 == 0 {
+			return fmt.Errorf("error %v", i)
+		}
+
+		return nil
+	})
+
+	require.Len(t, errs, n)
+	for i := 0; i < n; i++ {
+		require.Equal(t, i*2, res[i])
+		if i%10 == 0 {
+			require.EqualError(t, errs[i], fmt.Sprintf("error %v", i))
+		} else {
+			require.NoError(t, errs[i])
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) RetrieveMarketCollateralChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketCollateralChange, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveMarketCollateralChanges(opts)
+}
+
+func (s *Service) RetrieveMarketCollateralChangesLimit(limit uint64) ([]*models.MarketCollateralChange, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*models.MarketCollateralChange{}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChangesLimit").Infof(
+		"fetching market collateral changes with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChangesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveMarketCollateralChanges(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChangesLimit").Infof("task completed successfully")
+
+	return changes, nil
+}
+
+// retrieveMarketCollateralChanges is used to retrieve "MarketCollateralDeposited" and "MarketCollateralWithdrawn"
+// events with given filter options merged to one stream ordered by block number and log index
+func (s *Service) retrieveMarketCollateralChanges(opts *bind.FilterOpts) ([]*models.MarketCollateralChange, error) {
+	deposits, err := s.retrieveMarketCollateralDeposits(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := s.retrieveMarketCollateralWithdrawals(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*models.MarketCollateralChange, 0, len(deposits)+len(withdrawals))
+	changes = append(changes, deposits...)
+	changes = append(changes, withdrawals...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].BlockNumber != changes[j].BlockNumber {
+			return changes[i].BlockNumber < changes[j].BlockNumber
+		}
+
+		return changes[i].LogIndex < changes[j].LogIndex
+	})
+
+	return changes, nil
+}
+
+// retrieveMarketCollateralDeposits is used to retrieve "MarketCollateralDeposited" events as market collateral changes
+// with given filter options
+func (s *Service) retrieveMarketCollateralDeposits(opts *bind.FilterOpts) ([]*models.MarketCollateralChange, error) {
+	iterator, err := s.core.FilterMarketCollateralDeposited(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChanges").Errorf("error get deposited iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.MarketCollateralChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChanges").Errorf("deposited iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetMarketCollateralChangeFromDepositedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrieveMarketCollateralWithdrawals is used to retrieve "MarketCollateralWithdrawn" events as market collateral
+// changes with given filter options
+func (s *Service) retrieveMarketCollateralWithdrawals(opts *bind.FilterOpts) ([]*models.MarketCollateralChange, error) {
+	iterator, err := s.core.FilterMarketCollateralWithdrawn(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChanges").Errorf("error get withdrawn iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.MarketCollateralChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketCollateralChanges").Errorf("withdrawn iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetMarketCollateralChangeFromWithdrawnEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
@@ -304,33 +304,431 @@ func (s *Service) GetMarketIDs() ([]*big.Int, error) {
 	return res, nil
 }
 
+func (s *Service) GetMarkets() ([]*models.MarketMetadata, error) {
+	ids, err := s.GetMarketIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	markets := []*models.MarketMetadata{}
+	for _, id := range ids {
+		metadata, err := s.GetMarketMetadata(id)
+		if err != nil {
+			logger.Log().WithField("layer", "Service-GetMarkets").Warningf(
+				"skipping market %v, get metadata error: %v", id.String(), err.Error(),
+			)
+			continue
+		}
+
+		markets = append(markets, metadata)
+	}
+
+	return markets, nil
+}
+
+func (s *Service) GetOrderFees(marketId *big.Int) (*models.OrderFees, error) {
+	if marketId == nil {
+		logger.Log().WithField("layer", "Service-GetOrderFees").Errorf("received nil market id")
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	resp, err := s.perpsMarket.GetOrderFees(nil, marketId)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetOrderFees").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perpsMarket", "getOrderFees")
+	}
+
+	fees := models.GetOrderFees(resp)
+	fees.MarketID = marketId.Uint64()
+
+	return fees, nil
+}
+
+func (s *Service) ComputeOrderFees(marketId *big.Int, sizeDelta *big.Int) (*models.OrderFeeQuote, error) {
+	if marketId == nil || sizeDelta == nil {
+		logger.Log().WithField("layer", "Service-ComputeOrderFees").Errorf("received nil market id or size delta")
+		return nil, errors.GetInvalidArgumentErr("market id and size delta cannot be nil")
+	}
+
+	resp, err := s.perpsMarket.ComputeOrderFees(nil, marketId, sizeDelta)
+	if err != nil {
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-ComputeOrderFees").Errorf("oracle data required")
+			return nil, errors.GetOracleDataRequiredErr(err, "perpsMarket", "computeOrderFees")
+		}
+
+		logger.Log().WithField("layer", "Service-ComputeOrderFees").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perpsMarket", "computeOrderFees")
+	}
+
+	return models.GetOrderFeeQuote(resp), nil
+}
+
 func (s *Service) GetLiquidationParameters(marketId *big.Int) (*models.LiquidationParameters, error) {
+	if marketId == nil {
+		logger.Log().WithField("layer", "Service-GetLiquidationParameters").Errorf("received nil market id")
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
 	resp, err := s.perpsMarket.GetLiquidationParameters(nil, marketId)
 	if err != nil {
-		logger.Log().WithField("layer", "").Errorf("")
-		return nil, errors.GetReadContractErr(err, "", "")
+		logger.Log().WithField("layer", "Service-GetLiquidationParameters").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perpsMarket", "getLiquidationParameters")
 	}
 
-	return models.GetLiquidationParameters(resp), nil
+	params := models.GetLiquidationParameters(resp)
+	params.MarketID = marketId.Uint64()
+
+	return params, nil
 }
 
 func (s *Service) GetFundingParameters(marketId *big.Int) (*models.FundingParameters, error) {
+	if marketId == nil {
+		logger.Log().WithField("layer", "Service-GetFundingParameters").Errorf("received nil market id")
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
 	resp, err := s.perpsMarket.GetFundingParameters(nil, marketId)
 	if err != nil {
-		logger.Log().WithField("layer", "").Errorf("")
-		return nil, errors.GetReadContractErr(err, "", "")
+		logger.Log().WithField("layer", "Service-GetFundingParameters").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perpsMarket", "getFundingParameters")
+	}
+
+	// every configured market has non-zero skew scale, so zero value means the market does not exist
+	if resp.SkewScale == nil || resp.SkewScale.Sign() == 0 {
+		logger.Log().WithField("layer", "Service-GetFundingParameters").Errorf("received zero skew scale from the contract")
+		return nil, errors.GetInvalidArgumentErr("market does not exist")
 	}
 
-	return models.GetFundingParameters(resp), nil
+	params := models.GetFundingParameters(resp)
+	params.MarketID = marketId.Uint64()
+
+	return params, nil
 }
 
 func (s *Service) GetFoundingRate(marketId *big.Int) (*big.Int, error) {
-	rate, err := s.perpsMarket.CurrentFundingRate(nil, marketId)
+	return s.GetFundingRate(marketId)
+}
+
+func (s *Service) GetFundingRate(marketID *big.Int) (*big.Int, error) {
+	return s.getOracleMarketValue(
+		nil,
+		marketID,
+		"currentFundingRate",
+		s.perpsMarket.CurrentFundingRate,
+		func() ([]byte, error) { return s.rawPerpsContract.GetCallDataCurrentFundingRate(marketID) },
+		func(value []byte) (*big.Int, error) { return s.rawPerpsContract.UnpackCurrentFundingRate(value) },
+	)
+}
+
+func (s *Service) GetFundingVelocity(marketID *big.Int) (*big.Int, error) {
+	return s.getMarketValue(nil, marketID, "currentFundingVelocity", s.perpsMarket.CurrentFundingVelocity)
+}
+
+func (s *Service) GetMarketSize(marketID *big.Int) (*big.Int, error) {
+	if marketID == nil {
+		logger.Log().WithField("layer", "Service-GetMarketSize").Errorf("received nil market id")
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	res, err := s.getMarketSummaryRetries(marketID, s.multicallRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Size, nil
+}
+
+func (s *Service) GetMarketSkew(marketID *big.Int) (*big.Int, error) {
+	return s.getMarketValue(nil, marketID, "skew", s.perpsMarket.Skew)
+}
+
+func (s *Service) GetMaxOpenInterest(marketID *big.Int) (*big.Int, error) {
+	return s.getMarketValue(nil, marketID, "maxOpenInterest", s.perpsMarket.MaxOpenInterest)
+}
+
+func (s *Service) GetMaxMarketSize(marketID *big.Int) (*big.Int, error) {
+	return s.getMarketValue(nil, marketID, "getMaxMarketSize", s.perpsMarket.GetMaxMarketSize)
+}
+
+func (s *Service) GetIndexPrice(marketID *big.Int) (*big.Int, error) {
+	return s.getIndexPrice(nil, marketID)
+}
+
+// getIndexPrice is used to get perps market index price for given market ID with given call options
+func (s *Service) getIndexPrice(opts *bind.CallOpts, marketID *big.Int) (*big.Int, error) {
+	return s.getOracleMarketValue(
+		opts,
+		marketID,
+		"indexPrice",
+		s.perpsMarket.IndexPrice,
+		func() ([]byte, error) { return s.rawPerpsContract.GetCallDataIndexPrice(marketID) },
+		func(value []byte) (*big.Int, error) { return s.rawPerpsContract.UnpackIndexPrice(value) },
+	)
+}
+
+func (s *Service) GetFillPrice(marketID *big.Int, sizeDelta *big.Int) (*big.Int, error) {
+	if sizeDelta == nil {
+		logger.Log().WithField("layer", "Service-GetFillPrice").Errorf("received nil size delta")
+		return nil, errors.GetInvalidArgumentErr("size delta cannot be nil")
+	}
+
+	if marketID == nil {
+		logger.Log().WithField("layer", "Service-GetFillPrice").Errorf("received nil market id")
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	// index price and fill price are read from the same block. Multicall based chains always call the latest block, so
+	// both prices can be read from different blocks if new blocks are produced in between
+	opts, _, err := s.getLatestBlockCallOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := s.getIndexPrice(opts, marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getOracleMarketValue(
+		opts,
+		marketID,
+		"fillPrice",
+		func(opts *bind.CallOpts, marketId *big.Int) (*big.Int, error) {
+			return s.perpsMarket.FillPrice(opts, marketId, sizeDelta, price)
+		},
+		func() ([]byte, error) { return s.rawPerpsContract.GetCallDataFillPrice(marketID, sizeDelta, price) },
+		func(value []byte) (*big.Int, error) { return s.rawPerpsContract.UnpackFillPrice(value) },
+	)
+}
+
+// getMarketValue is used to call given perps market view function which returns single value for given market ID
+// with given call options, nil options are used to call the latest block. Calls reverted with `InvalidMarket` error are
+// treated as calls for not existing market
+func (s *Service) getMarketValue(
+	opts *bind.CallOpts,
+	marketID *big.Int,
+	method string,
+	call func(opts *bind.CallOpts, marketId *big.Int) (*big.Int, error),
+) (*big.Int, error) {
+	if marketID == nil {
+		logger.Log().WithField("layer", "Service-getMarketValue").Errorf("received nil market id for %v", method)
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	res, err := call(opts, marketID)
+	if err != nil {
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-getMarketValue").Errorf(
+				"contract error calling %v, oracle data required", method,
+			)
+			return nil, errors.GetOracleDataRequiredErr(err, "perpsMarket", method)
+		}
+
+		if isRevertErr(err, invalidMarketSelector) {
+			logger.Log().WithField("layer", "Service-getMarketValue").Errorf(
+				"contract error calling %v, market does not exist", method,
+			)
+			return nil, errors.GetInvalidArgumentErr("market does not exist")
+		}
+
+		logger.Log().WithField("layer", "Service-getMarketValue").Errorf(
+			"error from the contract calling %v: %v", method, err.Error(),
+		)
+		return nil, errors.GetReadContractErr(err, "perpsMarket", method)
+	}
+
+	return res, nil
+}
+
+// getOracleMarketValue is used to get single value for given market ID from the perps market view function which
+// depends on the oracle price. On networks with trusted forwarder the view is called with perps multicall retries at
+// the latest block, other networks call given contract binding function with given call options
+func (s *Service) getOracleMarketValue(
+	opts *bind.CallOpts,
+	marketID *big.Int,
+	method string,
+	call func(opts *bind.CallOpts, marketId *big.Int) (*big.Int, error),
+	callData func() ([]byte, error),
+	unpack func(value []byte) (*big.Int, error),
+) (*big.Int, error) {
+	if !s.isPerpsMultiCall() {
+		return s.getMarketValue(opts, marketID, method, call)
+	}
+
+	if marketID == nil {
+		logger.Log().WithField("layer", "Service-getOracleMarketValue").Errorf("received nil market id for %v", method)
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	data, err := callData()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.perpsMultiCallRetries(data, []*big.Int{marketID}, 0)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-getOracleMarketValue").Errorf(
+			"error from the multicall calling %v: %v", method, err.Error(),
+		)
+		return nil, err
+	}
+
+	return unpack(res)
+}
+
+// isPerpsMultiCall is used to check if oracle dependent perps market views are called with the trusted forwarder
+// multicall. Networks without configured trusted forwarder always call the contract bindings
+func (s *Service) isPerpsMultiCall() bool {
+	switch {
+	case s.rawForwarder == nil:
+		return false
+	case s.chainID == config.BaseAndromeda || s.chainID == config.BaseSepolia || s.chainID == config.BaseMainnet:
+		return true
+	default:
+		return false
+	}
+}
+
+// perpsMultiCallRetries is used to call given perps market call data using Forwarder contract with retries. Base
+// mainnet multicall fulfills oracle queries for given market IDs price feeds before the call, nil market IDs are used
+// to fulfill queries for all perps markets
+func (s *Service) perpsMultiCallRetries(callData []byte, marketIDs []*big.Int, fails int) (res []byte, err error) {
+	switch {
+	case s.chainID == config.BaseMainnet:
+		res, err = s.perpsMultiCall(callData, marketIDs, true)
+	default:
+		res, err = s.perpsMultiCallNoPyth(callData, marketIDs, true)
+	}
+
+	if err != nil && fails <= s.multicallRetries {
+		time.Sleep(s.multicallWait)
+		return s.perpsMultiCallRetries(callData, marketIDs, fails+1)
+	}
+
+	return res, err
+}
+
+// perpsMultiCallNoPyth is used to call given perps market call data using Forwarder contract with no erc7412 wrapper
+func (s *Service) perpsMultiCallNoPyth(callData []byte, marketIDs []*big.Int, retry bool) ([]byte, error) {
+	callPerps := forwarder.TrustedMulticallForwarderCall3Value{
+		Target:         s.rawPerpsContract.Address(),
+		RequireSuccess: true,
+		Value:          big.NewInt(0),
+		CallData:       callData,
+	}
+
+	call, err := s.rawForwarder.Aggregate3Value(0, []forwarder.TrustedMulticallForwarderCall3Value{callPerps})
 	if err != nil {
-		return nil, errors.GetReadContractErr(err, "perpsMarket", "currentFoundingRate")
+		if retry {
+			return s.perpsMultiCall(callData, marketIDs, false)
+		}
+
+		return nil, err
+	}
+
+	if len(call) != 1 {
+		logger.Log().WithField("layer", "perpsMultiCallNoPyth").Errorf("received %v from rawForwarder contract, expected 1", len(call))
+		return nil, errors.GetReadContractErr(fmt.Errorf("invalid call"), "rawForwarder", "Aggregate3Value")
 	}
 
-	return rate, nil
+	if !call[0].Success {
+		logger.Log().WithField("layer", "perpsMultiCallNoPyth").Error("call to perps unsuccessful")
+		return nil, errors.GetReadContractErr(fmt.Errorf("invalid call to perps"), "rawForwarder", "Aggregate3Value")
+	}
+
+	return call[0].ReturnData, nil
+}
+
+// perpsMultiCall is used to call given perps market call data using Forwarder contract after fulfilling oracle queries
+// for given market IDs price feeds
+func (s *Service) perpsMultiCall(callData []byte, marketIDs []*big.Int, retry bool) ([]byte, error) {
+	callPerps := forwarder.TrustedMulticallForwarderCall3Value{
+		Target:         s.rawPerpsContract.Address(),
+		RequireSuccess: true,
+		Value:          big.NewInt(0),
+		CallData:       callData,
+	}
+
+	callFulfill, err := s.getFulfillOracleQueryCall(marketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := s.rawForwarder.Aggregate3Value(
+		callFulfill.Value.Uint64(),
+		[]forwarder.TrustedMulticallForwarderCall3Value{callFulfill, callPerps},
+	)
+	if err != nil {
+		if retry {
+			return s.perpsMultiCallNoPyth(callData, marketIDs, false)
+		}
+
+		return nil, err
+	}
+
+	if len(call) != 2 {
+		logger.Log().WithField("layer", "perpsMultiCall").Errorf("received %v from rawForwarder contract, expected 2", len(call))
+		return nil, errors.GetReadContractErr(fmt.Errorf("invalid call"), "rawForwarder", "Aggregate3Value")
+	}
+
+	if !call[0].Success {
+		logger.Log().WithField("layer", "perpsMultiCall").Error("call to erc7412 unsuccessful")
+		return nil, errors.GetReadContractErr(fmt.Errorf("invalid call to erc7412"), "rawForwarder", "Aggregate3Value")
+	}
+
+	if !call[1].Success {
+		logger.Log().WithField("layer", "perpsMultiCall").Error("call to perps unsuccessful")
+		return nil, errors.GetReadContractErr(fmt.Errorf("invalid call to perps market"), "rawForwarder", "Aggregate3Value")
+	}
+
+	return call[1].ReturnData, nil
+}
+
+// getFulfillOracleQueryCall is used to get erc7412 fulfill oracle query call for given market IDs price feeds. Queries
+// for all perps markets are used if nil market IDs are given. Call values are the same as in market summary and
+// required margins multicalls
+func (s *Service) getFulfillOracleQueryCall(marketIDs []*big.Int) (res forwarder.TrustedMulticallForwarderCall3Value, err error) {
+	if marketIDs == nil {
+		marketIDs, err = s.GetMarketIDs()
+		if err != nil {
+			return res, err
+		}
+	}
+
+	feedIDs := make([]string, 0, len(marketIDs))
+	for _, m := range marketIDs {
+		feedID := models.GetPriceFeedIDFromMarketID(m)
+		if feedID == models.UNKNOWN {
+			logger.Log().WithField("layer", "getFulfillOracleQueryCall").Errorf(
+				"market id: %v has no known price feed", m.String(),
+			)
+			return res, errors.GetReadContractErr(fmt.Errorf("market %v not supported", m.String()), "rawForwarder", "Aggregate3Value")
+		}
+
+		feedIDs = append(feedIDs, feedID.String())
+	}
+
+	var fulfillOracleQueryCallData []byte
+	value := big.NewInt(1)
+
+	if len(feedIDs) == 1 {
+		fulfillOracleQueryCallData, err = s.rawERC7412.GetCallFulfillOracleQuery(feedIDs[0])
+	} else {
+		fulfillOracleQueryCallData, err = s.rawERC7412.GetCallFulfillOracleQueryAll(feedIDs)
+		value = big.NewInt(2)
+	}
+
+	if err != nil {
+		return res, err
+	}
+
+	return forwarder.TrustedMulticallForwarderCall3Value{
+		Target:         s.rawERC7412.Address(),
+		RequireSuccess: true,
+		Value:          value,
+		CallData:       fulfillOracleQueryCallData,
+	}, nil
 }
 
 // retrieveMarketUpdates is used to get retrieve market updates with given filter options
@@ -412,3 +810,90 @@ func (s *Service) getMarketUpdateBig(event *perpsMarket.PerpsMarketMarketUpdated
 
 	return models.GetMarketUpdateBigFromEvent(event, block.Time), nil
 }
+
+func (s *Service) RetrieveMarketsCreated(fromBlock uint64, toBLock *uint64) ([]*models.MarketCreated, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveMarketsCreated(opts)
+}
+
+func (s *Service) RetrieveMarketsCreatedLimit(limit uint64) ([]*models.MarketCreated, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var markets []*models.MarketCreated
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketsCreatedLimit").Infof(
+		"fetching created markets with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMarketsCreatedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveMarketsCreated(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		markets = append(markets, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketsCreatedLimit").Infof("task completed successfully")
+
+	return markets, nil
+}
+
+// retrieveMarketsCreated is used to retrieve created markets with given filter options
+func (s *Service) retrieveMarketsCreated(opts *bind.FilterOpts) ([]*models.MarketCreated, error) {
+	iterator, err := s.perpsMarket.FilterMarketCreated(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketsCreated").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var markets []*models.MarketCreated
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketsCreated").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		market, err := s.getMarketCreated(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		markets = append(markets, market)
+	}
+
+	return markets, nil
+}
+
+// getMarketCreated is used to get models.MarketCreated from given event and block number
+func (s *Service) getMarketCreated(event *perpsMarket.PerpsMarketMarketCreated, blockN uint64) (*models.MarketCreated, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketsCreated").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetMarketCreatedFromEvent(event, block.Time), nil
+}
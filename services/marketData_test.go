@@ -1,20 +1,26 @@
 package services
 
 import (
+	"context"
+	"fmt"
 	"github.com/gateway-fm/perpsv3-Go/config"
 	"log"
 	"math/big"
 	"os"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/stretchr/testify/require"
 
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/forwarder"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
 	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/rawContracts"
 )
 
 func TestService_RetrieveMarketUpdates_OnChain(t *testing.T) {
@@ -66,7 +72,7 @@ func TestService_RetrieveMarketUpdates_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 			res, err := s.RetrieveMarketUpdates(tt.startBlock, &tt.endBlock)
 
 			require.NoError(t, err)
@@ -135,7 +141,7 @@ func TestService_RetrieveMarketUpdatesBig_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 			res, err := s.RetrieveMarketUpdatesBig(tt.startBlock, &tt.endBlock)
 
 			require.NoError(t, err)
@@ -165,7 +171,7 @@ func TestService_RetrieveMarketUpdates_OnChain_Limit(t *testing.T) {
 	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
 	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
 
-	s, _ := NewService(rpcClient, conf, coreC, perps)
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 	_, err := s.RetrieveMarketUpdatesLimit(20000)
 
@@ -185,7 +191,7 @@ func TestService_RetrieveMarketUpdatesBig_OnChain_Limit(t *testing.T) {
 	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
 	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
 
-	s, _ := NewService(rpcClient, conf, coreC, perps)
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 	_, err := s.RetrieveMarketUpdatesBigLimit(20000)
 
@@ -241,7 +247,7 @@ func TestService_GetMarketMetadata_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 			res, err := s.GetMarketMetadata(tt.marketID)
 
@@ -294,7 +300,7 @@ func TestService_GetMarketSummary(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 			res, err := s.GetMarketSummary(tt.marketID)
 
@@ -341,7 +347,7 @@ func TestService_GetMarketIDs(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 			res, err := s.GetMarketIDs()
 
@@ -355,6 +361,34 @@ func TestService_GetMarketIDs(t *testing.T) {
 	}
 }
 
+func TestService_GetMarkets(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	ids, err := s.GetMarketIDs()
+	require.NoError(t, err)
+
+	res, err := s.GetMarkets()
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(res), len(ids))
+
+	for _, m := range res {
+		require.NotEqual(t, "", m.Name)
+		require.NotEqual(t, "", m.Symbol)
+	}
+}
+
 func TestService_GetFoundingRate(t *testing.T) {
 	rpc := os.Getenv("TEST_RPC")
 	if rpc == "" {
@@ -384,7 +418,7 @@ func TestService_GetFoundingRate(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 			res, err := s.GetFoundingRate(tt.id)
 
@@ -399,3 +433,1038 @@ func TestService_GetFoundingRate(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetFundingParameters(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name:    "id 300",
+			id:      big.NewInt(300),
+			wantErr: errors.InvalidArgumentErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetFundingParameters(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.id.Uint64(), res.MarketID)
+				require.NotNil(t, res.SkewScale)
+				require.NotNil(t, res.MaxFundingVelocity)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetLiquidationParameters(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetLiquidationParameters(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.id.Uint64(), res.MarketID)
+				require.NotNil(t, res.InitialMarginRatio)
+				require.NotNil(t, res.MinimumInitialMarginRatio)
+				require.NotNil(t, res.MaintenanceMarginScalar)
+				require.NotNil(t, res.LiquidationRewardRatio)
+				require.NotNil(t, res.MinimumPositionMargin)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_RetrieveFundingParametersSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveFundingParametersSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveMarketsCreated_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveMarketsCreatedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveLiquidationParametersSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveLiquidationParametersSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveMaxLiquidationParametersSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveMaxLiquidationParametersSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveMaxMarketSizesSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveMaxMarketSizesSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveOrderFeesSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveOrderFeesSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveMarketPriceDataUpdated_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveMarketPriceDataUpdatedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_GetFundingRate(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name: "id 200",
+			id:   big.NewInt(200),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetFundingRate(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetFundingVelocity(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name: "id 200",
+			id:   big.NewInt(200),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetFundingVelocity(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetMarketSize(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name: "id 200",
+			id:   big.NewInt(200),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetMarketSize(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetMarketSize_RecordedCall(t *testing.T) {
+	perpsABI, err := perpsMarket.PerpsMarketMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// market summary fixture with 1 049 units of open interest
+	size, _ := new(big.Int).SetString("1049000000000000000000", 10)
+
+	data, err := perpsABI.Methods["getMarketSummary"].Outputs.Pack(perpsMarket.IPerpsMarketModuleMarketSummary{
+		Skew:                   big.NewInt(527000000000000000),
+		Size:                   size,
+		MaxOpenInterest:        big.NewInt(0),
+		CurrentFundingRate:     big.NewInt(0),
+		CurrentFundingVelocity: big.NewInt(0),
+		IndexPrice:             big.NewInt(0),
+	})
+	require.NoError(t, err)
+
+	newService := func(caller *recordedCaller) *Service {
+		perpsCaller, err := perpsMarket.NewPerpsMarketCaller(
+			common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+			caller,
+		)
+		require.NoError(t, err)
+
+		return &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *perpsCaller}}
+	}
+
+	res, err := newService(&recordedCaller{data: data}).GetMarketSize(big.NewInt(200))
+	require.NoError(t, err)
+	require.Equal(t, size, res)
+
+	_, err = newService(&recordedCaller{err: &revertErr{}}).GetMarketSize(big.NewInt(1))
+	require.ErrorIs(t, err, errors.InvalidArgumentErr)
+
+	_, err = newService(&recordedCaller{}).GetMarketSize(nil)
+	require.ErrorIs(t, err, errors.InvalidArgumentErr)
+}
+
+func TestService_GetMarketSkew(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name: "id 200",
+			id:   big.NewInt(200),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetMarketSkew(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetMaxOpenInterest(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name: "id 200",
+			id:   big.NewInt(200),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetMaxOpenInterest(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetIndexPrice(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "id 100",
+			id:   big.NewInt(100),
+		},
+		{
+			name: "id 200",
+			id:   big.NewInt(200),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetIndexPrice(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetFillPrice(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	// 1 000 units is beyond current skew of the test market while staying well below its skew scale, so the orders
+	// below cross the skew without pushing the fill price below zero
+	bigSize, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	testCases := []struct {
+		name      string
+		id        *big.Int
+		sizeDelta *big.Int
+		wantErr   error
+	}{
+		{
+			name:      "nil id",
+			sizeDelta: big.NewInt(0),
+			wantErr:   errors.InvalidArgumentErr,
+		},
+		{
+			name:    "nil size delta",
+			id:      big.NewInt(100),
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:      "zero size delta",
+			id:        big.NewInt(100),
+			sizeDelta: big.NewInt(0),
+		},
+		{
+			name:      "long size crossing the skew",
+			id:        big.NewInt(100),
+			sizeDelta: bigSize,
+		},
+		{
+			name:      "short size crossing the skew",
+			id:        big.NewInt(100),
+			sizeDelta: new(big.Int).Neg(bigSize),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetFillPrice(tt.id, tt.sizeDelta)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, 1, res.Sign())
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("long fill price above short fill price", func(t *testing.T) {
+		s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+		zero, err := s.GetFillPrice(big.NewInt(100), big.NewInt(0))
+		require.NoError(t, err)
+
+		long, err := s.GetFillPrice(big.NewInt(100), bigSize)
+		require.NoError(t, err)
+
+		short, err := s.GetFillPrice(big.NewInt(100), new(big.Int).Neg(bigSize))
+		require.NoError(t, err)
+
+		require.Equal(t, 1, long.Cmp(zero))
+		require.Equal(t, -1, short.Cmp(zero))
+	})
+}
+
+func TestService_GetMaxMarketSize_RecordedCall(t *testing.T) {
+	// getMaxMarketSize return data fixture for the market with 1 500 units cap
+	data := common.FromHex("0x00000000000000000000000000000000000000000000005150ae84a8cdf00000")
+
+	caller, err := perpsMarket.NewPerpsMarketCaller(
+		common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller}}
+
+	want, _ := new(big.Int).SetString("1500000000000000000000", 10)
+
+	res, err := s.GetMaxMarketSize(big.NewInt(100))
+
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
+
+func TestService_GetOrderFees_RecordedCall(t *testing.T) {
+	// getOrderFees return data fixture for the market with 0.02% maker and 0.05% taker fee ratios
+	data := common.FromHex("0x" +
+		"0000000000000000000000000000000000000000000000000000b5e620f48000" +
+		"0000000000000000000000000000000000000000000000000001c6bf52634000",
+	)
+
+	caller, err := perpsMarket.NewPerpsMarketCaller(
+		common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller}}
+
+	res, err := s.GetOrderFees(big.NewInt(100))
+
+	require.NoError(t, err)
+	require.Equal(t, &models.OrderFees{
+		MarketID:      100,
+		MakerFeeRatio: big.NewInt(200000000000000),
+		TakerFeeRatio: big.NewInt(500000000000000),
+	}, res)
+
+	_, err = s.GetOrderFees(nil)
+	require.ErrorIs(t, err, errors.InvalidArgumentErr)
+}
+
+func TestService_ComputeOrderFees(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	// 1 000 units is beyond current skew of the test market, so orders of this size flip the skew and are charged
+	// with both maker and taker fees
+	flipSize, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	testCases := []struct {
+		name      string
+		id        *big.Int
+		sizeDelta *big.Int
+		wantErr   error
+	}{
+		{
+			name:      "nil id",
+			sizeDelta: big.NewInt(1),
+			wantErr:   errors.InvalidArgumentErr,
+		},
+		{
+			name:    "nil size delta",
+			id:      big.NewInt(100),
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:      "small long",
+			id:        big.NewInt(100),
+			sizeDelta: big.NewInt(1000000000000000),
+		},
+		{
+			name:      "small short",
+			id:        big.NewInt(100),
+			sizeDelta: big.NewInt(-1000000000000000),
+		},
+		{
+			name:      "long flipping the skew",
+			id:        big.NewInt(100),
+			sizeDelta: flipSize,
+		},
+		{
+			name:      "short flipping the skew",
+			id:        big.NewInt(100),
+			sizeDelta: new(big.Int).Neg(flipSize),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.ComputeOrderFees(tt.id, tt.sizeDelta)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, 1, res.OrderFees.Sign())
+				require.Equal(t, 1, res.FillPrice.Sign())
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_getMarketValue(t *testing.T) {
+	perpsABI, err := perpsMarket.PerpsMarketMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// short skewed market fixture: -1 250.5 units of skew
+	shortSkew, _ := new(big.Int).SetString("-1250500000000000000000", 10)
+
+	// int256 encoded skew return data as returned by the contract
+	shortSkewData, err := perpsABI.Methods["skew"].Outputs.Pack(shortSkew)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		marketID *big.Int
+		data     []byte
+		err      error
+		want     *big.Int
+		wantErr  error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:     "short skewed market",
+			marketID: big.NewInt(100),
+			data:     shortSkewData,
+			want:     shortSkew,
+		},
+		{
+			name:     "not existing market",
+			marketID: big.NewInt(300),
+			err: &revertErr{
+				data: "0x6766b640000000000000000000000000000000000000000000000000000000000000012c",
+			},
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:     "other custom error revert",
+			marketID: big.NewInt(100),
+			err: &revertErr{
+				data: "0xee90c4680000000000000000000000000000000000000000000000000000000000000064",
+			},
+			wantErr: errors.ReadContractErr,
+		},
+		{
+			name:     "oracle data required",
+			marketID: big.NewInt(100),
+			err: &revertErr{
+				data: "0xcf2cabdf0000000000000000000000000000000000000000000000000000000000000000",
+			},
+			wantErr: errors.OracleDataRequiredErr,
+		},
+		{
+			name:     "rpc error",
+			marketID: big.NewInt(100),
+			err:      fmt.Errorf("connection refused"),
+			wantErr:  errors.ReadContractErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := perpsMarket.NewPerpsMarketCaller(
+				common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+				&recordedCaller{data: tt.data, err: tt.err},
+			)
+			require.NoError(t, err)
+
+			s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller}}
+
+			res, err := s.getMarketValue(nil, tt.marketID, "skew", s.perpsMarket.Skew)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.want.String(), res.String())
+				require.Equal(t, -1, res.Sign())
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_OracleViews_MultiCall(t *testing.T) {
+	perpsABI, err := perpsMarket.PerpsMarketMetaData.GetAbi()
+	require.NoError(t, err)
+
+	rawPerps, err := rawContracts.NewPerps(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), nil)
+	require.NoError(t, err)
+
+	// ETH market index price fixture: 2 250.75 USD
+	price, _ := new(big.Int).SetString("2250750000000000000000", 10)
+
+	priceData, err := perpsABI.Methods["indexPrice"].Outputs.Pack(price)
+	require.NoError(t, err)
+
+	canLiquidateData, err := perpsABI.Methods["canLiquidate"].Outputs.Pack(true)
+	require.NoError(t, err)
+
+	newService := func(fwd *recordedForwarder) *Service {
+		return &Service{
+			chainID:          config.BaseAndromeda,
+			perpsMarket:      &perpsMarket.PerpsMarket{},
+			rawPerpsContract: rawPerps,
+			rawForwarder:     fwd,
+		}
+	}
+
+	t.Run("index price", func(t *testing.T) {
+		fwd := &recordedForwarder{results: []rawContracts.ForwarderResult{{Success: true, ReturnData: priceData}}}
+		s := newService(fwd)
+
+		res, err := s.GetIndexPrice(big.NewInt(100))
+
+		require.NoError(t, err)
+		require.Equal(t, price, res)
+
+		wantCallData, err := rawPerps.GetCallDataIndexPrice(big.NewInt(100))
+		require.NoError(t, err)
+		require.Len(t, fwd.calls, 1)
+		require.Len(t, fwd.calls[0], 1)
+		require.Equal(t, rawPerps.Address(), fwd.calls[0][0].Target)
+		require.Equal(t, wantCallData, fwd.calls[0][0].CallData)
+	})
+
+	t.Run("can liquidate", func(t *testing.T) {
+		fwd := &recordedForwarder{results: []rawContracts.ForwarderResult{{Success: true, ReturnData: canLiquidateData}}}
+		s := newService(fwd)
+
+		res, err := s.CanLiquidate(big.NewInt(1))
+
+		require.NoError(t, err)
+		require.True(t, res)
+		require.Len(t, fwd.calls, 1)
+	})
+
+	t.Run("nil market id", func(t *testing.T) {
+		fwd := &recordedForwarder{}
+		s := newService(fwd)
+
+		_, err := s.GetFundingRate(nil)
+
+		require.ErrorIs(t, err, errors.InvalidArgumentErr)
+		require.Empty(t, fwd.calls)
+	})
+
+	t.Run("failed multicall is retried", func(t *testing.T) {
+		fwd := &recordedForwarder{err: fmt.Errorf("connection refused")}
+		s := newService(fwd)
+		s.multicallRetries = 1
+
+		// market without known price feed, so the erc7412 fallback fails before the forwarder call
+		_, err := s.GetIndexPrice(big.NewInt(1))
+
+		require.ErrorIs(t, err, errors.ReadContractErr)
+		require.Len(t, fwd.calls, 3)
+	})
+
+	t.Run("no trusted forwarder", func(t *testing.T) {
+		caller, err := perpsMarket.NewPerpsMarketCaller(
+			common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+			&recordedCaller{data: priceData},
+		)
+		require.NoError(t, err)
+
+		s := &Service{
+			chainID:          config.BaseSepolia,
+			rawPerpsContract: rawPerps,
+			perpsMarket:      &perpsMarket.PerpsMarket{PerpsMarketCaller: *caller},
+		}
+
+		res, err := s.GetIndexPrice(big.NewInt(100))
+
+		require.NoError(t, err)
+		require.Equal(t, price, res)
+	})
+}
+
+func TestService_getIndexPrice_CallOpts(t *testing.T) {
+	perpsABI, err := perpsMarket.PerpsMarketMetaData.GetAbi()
+	require.NoError(t, err)
+
+	price, _ := new(big.Int).SetString("2250750000000000000000", 10)
+
+	data, err := perpsABI.Methods["indexPrice"].Outputs.Pack(price)
+	require.NoError(t, err)
+
+	caller := &blockCaller{recordedCaller: recordedCaller{data: data}}
+
+	perpsCaller, err := perpsMarket.NewPerpsMarketCaller(
+		common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"),
+		caller,
+	)
+	require.NoError(t, err)
+
+	s := &Service{perpsMarket: &perpsMarket.PerpsMarket{PerpsMarketCaller: *perpsCaller}}
+
+	opts := &bind.CallOpts{BlockNumber: big.NewInt(12345)}
+
+	res, err := s.getIndexPrice(opts, big.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, price, res)
+
+	fillPrice := func(opts *bind.CallOpts, marketId *big.Int) (*big.Int, error) {
+		return s.perpsMarket.FillPrice(opts, marketId, big.NewInt(1), res)
+	}
+
+	_, err = s.getOracleMarketValue(opts, big.NewInt(100), "fillPrice", fillPrice, nil, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []*big.Int{big.NewInt(12345), big.NewInt(12345)}, caller.blocks)
+}
+
+// blockCaller is a test bind.ContractCaller implementation recording block numbers of the calls
+type blockCaller struct {
+	recordedCaller
+	blocks []*big.Int
+}
+
+func (c *blockCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	c.blocks = append(c.blocks, block)
+	return c.recordedCaller.CallContract(ctx, msg, block)
+}
+
+// recordedForwarder is a test rawContracts.IRawForwarderContract implementation returning given results or error and
+// recording received calls
+type recordedForwarder struct {
+	results []rawContracts.ForwarderResult
+	err     error
+	calls   [][]forwarder.TrustedMulticallForwarderCall3Value
+}
+
+func (f *recordedForwarder) Aggregate3Value(
+	_ uint64,
+	arg []forwarder.TrustedMulticallForwarderCall3Value,
+) ([]rawContracts.ForwarderResult, error) {
+	f.calls = append(f.calls, arg)
+	return f.results, f.err
+}
+
+func (f *recordedForwarder) Address() common.Address {
+	return common.HexToAddress("0xE2C5658cC5C448B48141168f3e475dF8f65A1e3e")
+}
+
+// revertErr is a test rpc.DataError implementation of a reverted call with given revert data
+type revertErr struct {
+	data string
+}
+
+func (e *revertErr) Error() string          { return "execution reverted" }
+func (e *revertErr) ErrorData() interface{} { return e.data }
+
+// recordedCaller is a test bind.ContractCaller implementation returning given recorded call data or error
+type recordedCaller struct {
+	data []byte
+	err  error
+}
+
+func (c *recordedCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *recordedCaller) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return c.data, c.err
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetMarketReportedDebt(marketID *big.Int) (*big.Int, error) {
+	return s.getCoreMarketValue(marketID, "getMarketReportedDebt", s.core.GetMarketReportedDebt)
+}
+
+func (s *Service) GetMarketTotalDebt(marketID *big.Int) (*big.Int, error) {
+	return s.getCoreMarketValue(marketID, "getMarketTotalDebt", s.core.GetMarketTotalDebt)
+}
+
+func (s *Service) GetWithdrawableMarketUsd(marketID *big.Int) (*big.Int, error) {
+	return s.getCoreMarketValue(marketID, "getWithdrawableMarketUsd", s.core.GetWithdrawableMarketUsd)
+}
+
+func (s *Service) GetMarketDebtSummary(marketID *big.Int) (*models.MarketDebtSummary, error) {
+	reported, err := s.GetMarketReportedDebt(marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.GetMarketTotalDebt(marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawable, err := s.GetWithdrawableMarketUsd(marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MarketDebtSummary{
+		MarketID:        marketID,
+		ReportedDebt:    reported,
+		TotalDebt:       total,
+		WithdrawableUsd: withdrawable,
+	}, nil
+}
+
+func (s *Service) GetMarketNetIssuance(marketID *big.Int) (*big.Int, error) {
+	return s.getCoreMarketValue(marketID, "getMarketNetIssuance", s.core.GetMarketNetIssuance)
+}
+
+func (s *Service) GetMarketCollateralValue(marketID *big.Int) (*big.Int, error) {
+	return s.getCoreMarketValue(marketID, "getMarketCollateralValue", s.core.GetMarketCollateralValue)
+}
+
+func (s *Service) GetMarketBackingSummary(marketID *big.Int) (*models.MarketBackingSummary, error) {
+	netIssuance, err := s.GetMarketNetIssuance(marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	collateralValue, err := s.GetMarketCollateralValue(marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	reported, err := s.GetMarketReportedDebt(marketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MarketBackingSummary{
+		MarketID:        marketID,
+		NetIssuance:     netIssuance,
+		CollateralValue: collateralValue,
+		ReportedDebt:    reported,
+	}, nil
+}
+
+// getCoreMarketValue is used to call given core view function which returns single value for given market ID from
+// the latest block. Core does not revert for not registered markets, so only oracle reverts are distinguished
+func (s *Service) getCoreMarketValue(
+	marketID *big.Int,
+	method string,
+	call func(opts *bind.CallOpts, marketId *big.Int) (*big.Int, error),
+) (*big.Int, error) {
+	if marketID == nil {
+		logger.Log().WithField("layer", "Service-getCoreMarketValue").Errorf("received nil market id for %v", method)
+		return nil, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	res, err := call(nil, marketID)
+	if err != nil {
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-getCoreMarketValue").Errorf(
+				"contract error calling %v, oracle data required", method,
+			)
+			return nil, errors.GetOracleDataRequiredErr(err, "core", method)
+		}
+
+		logger.Log().WithField("layer", "Service-getCoreMarketValue").Errorf(
+			"error from the contract calling %v: %v", method, err.Error(),
+		)
+		return nil, errors.GetReadContractErr(err, "core", method)
+	}
+
+	return res, nil
+}
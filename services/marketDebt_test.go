@@ -0,0 +1,164 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+)
+
+func TestService_getCoreMarketValue(t *testing.T) {
+	credit, _ := new(big.Int).SetString("-2500000000000000000000", 10)
+
+	testCases := []struct {
+		name     string
+		marketID *big.Int
+		res      *big.Int
+		err      error
+		want     *big.Int
+		wantErr  error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:     "market in credit",
+			marketID: big.NewInt(2),
+			res:      credit,
+			want:     credit,
+		},
+		{
+			name:     "oracle data required",
+			marketID: big.NewInt(2),
+			err: &revertErr{
+				data: "0xcf2cabdf0000000000000000000000000000000000000000000000000000000000000000",
+			},
+			wantErr: errors.OracleDataRequiredErr,
+		},
+		{
+			name:     "rpc error",
+			marketID: big.NewInt(2),
+			err:      fmt.Errorf("connection refused"),
+			wantErr:  errors.ReadContractErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+
+			res, err := s.getCoreMarketValue(tt.marketID, "getMarketTotalDebt", func(_ *bind.CallOpts, _ *big.Int) (*big.Int, error) {
+				return tt.res, tt.err
+			})
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, res)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetMarketDebtSummary_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	withdrawable, err := s.GetWithdrawableMarketUsd(big.NewInt(2))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, withdrawable.Sign(), 0)
+
+	summary, err := s.GetMarketDebtSummary(big.NewInt(2))
+	if err != nil {
+		// reported debt of the perps market requires fresh price feeds
+		require.ErrorIs(t, err, errors.OracleDataRequiredErr)
+		return
+	}
+
+	require.Equal(t, big.NewInt(2), summary.MarketID)
+	require.NotNil(t, summary.ReportedDebt)
+	require.NotNil(t, summary.TotalDebt)
+	require.NotNil(t, summary.WithdrawableUsd)
+}
+
+func TestService_GetMarketNetIssuance_RecordedCall(t *testing.T) {
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	deposited, _ := new(big.Int).SetString("-500000000000000000000", 10)
+
+	data, err := coreABI.Methods["getMarketNetIssuance"].Outputs.Pack(deposited)
+	require.NoError(t, err)
+
+	caller, err := core.NewCoreCaller(
+		common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+	res, err := s.GetMarketNetIssuance(big.NewInt(2))
+
+	require.NoError(t, err)
+	require.Equal(t, deposited.String(), res.String())
+	require.Equal(t, -1, res.Sign())
+}
+
+func TestService_GetMarketBackingSummary_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.GetMarketNetIssuance(big.NewInt(2))
+	require.NoError(t, err)
+
+	collateralValue, err := s.GetMarketCollateralValue(big.NewInt(2))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, collateralValue.Sign(), 0)
+
+	summary, err := s.GetMarketBackingSummary(big.NewInt(2))
+	if err != nil {
+		// reported debt of the perps market requires fresh price feeds
+		require.ErrorIs(t, err, errors.OracleDataRequiredErr)
+		return
+	}
+
+	require.Equal(t, big.NewInt(2), summary.MarketID)
+	require.NotNil(t, summary.NetIssuance)
+	require.NotNil(t, summary.CollateralValue)
+	require.NotNil(t, summary.ReportedDebt)
+}
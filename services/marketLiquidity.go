@@ -0,0 +1,53 @@
+package services
+
+import (
+	"math/big"
+
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetMinLiquidityRatio() (*big.Int, error) {
+	ratio, err := s.core.GetMinLiquidityRatio0(nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetMinLiquidityRatio").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getMinLiquidityRatio")
+	}
+
+	return ratio, nil
+}
+
+func (s *Service) GetMarketMinLiquidityRatio(marketID *big.Int) (*models.MinLiquidityRatio, error) {
+	ratio, err := s.getCoreMarketValue(marketID, "getMinLiquidityRatio", s.core.GetMinLiquidityRatio)
+	if err != nil {
+		return nil, err
+	}
+
+	// core falls back to the system wide value for markets without override, which is stored as 0
+	if ratio.Sign() != 0 {
+		return &models.MinLiquidityRatio{MarketID: marketID, RatioD18: ratio}, nil
+	}
+
+	system, err := s.GetMinLiquidityRatio()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MinLiquidityRatio{MarketID: marketID, RatioD18: system, SystemDefault: true}, nil
+}
+
+func (s *Service) GetMarketMinDelegateTime(marketID *big.Int) (uint32, error) {
+	if marketID == nil {
+		logger.Log().WithField("layer", "Service-GetMarketMinDelegateTime").Errorf("received nil market id")
+		return 0, errors.GetInvalidArgumentErr("market id cannot be nil")
+	}
+
+	res, err := s.core.GetMarketMinDelegateTime(nil, marketID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetMarketMinDelegateTime").Errorf("error from the contract: %v", err.Error())
+		return 0, errors.GetReadContractErr(err, "core", "getMarketMinDelegateTime")
+	}
+
+	return res, nil
+}
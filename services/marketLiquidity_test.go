@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+)
+
+// selectorCaller is a test bind.ContractCaller implementation returning recorded call data by hex encoded method
+// selector of the call
+type selectorCaller struct {
+	data map[string][]byte
+}
+
+func (c *selectorCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *selectorCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return c.data[hexutil.Encode(msg.Data[:4])], nil
+}
+
+func TestService_GetMarketMinLiquidityRatio_RecordedCall(t *testing.T) {
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	marketSelector := hexutil.Encode(coreABI.Methods["getMinLiquidityRatio"].ID)
+	systemSelector := hexutil.Encode(coreABI.Methods["getMinLiquidityRatio0"].ID)
+
+	market := big.NewInt(2000000000000000000)
+	system := big.NewInt(1500000000000000000)
+
+	testCases := []struct {
+		name              string
+		marketID          *big.Int
+		marketRatio       *big.Int
+		want              string
+		wantSystemDefault bool
+		wantErr           error
+	}{
+		{
+			name:    "nil market id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:        "market override",
+			marketID:    big.NewInt(2),
+			marketRatio: market,
+			want:        market.String(),
+		},
+		{
+			name:              "system default",
+			marketID:          big.NewInt(3),
+			marketRatio:       big.NewInt(0),
+			want:              system.String(),
+			wantSystemDefault: true,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string][]byte{systemSelector: common.LeftPadBytes(system.Bytes(), 32)}
+			if tt.marketRatio != nil {
+				data[marketSelector] = common.LeftPadBytes(tt.marketRatio.Bytes(), 32)
+			}
+
+			caller, err := core.NewCoreCaller(
+				common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				&selectorCaller{data: data},
+			)
+			require.NoError(t, err)
+
+			s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+			res, err := s.GetMarketMinLiquidityRatio(tt.marketID)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.marketID, res.MarketID)
+				require.Equal(t, tt.want, res.RatioD18.String())
+				require.Equal(t, tt.wantSystemDefault, res.SystemDefault)
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetMarketMinLiquidityRatio_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	system, err := s.GetMinLiquidityRatio()
+	require.NoError(t, err)
+
+	res, err := s.GetMarketMinLiquidityRatio(big.NewInt(2))
+	require.NoError(t, err)
+	if res.SystemDefault {
+		require.Equal(t, system.String(), res.RatioD18.String())
+	}
+
+	_, err = s.GetMarketMinDelegateTime(big.NewInt(2))
+	require.NoError(t, err)
+}
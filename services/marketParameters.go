@@ -0,0 +1,541 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) RetrieveFundingParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.FundingParameters, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveFundingParametersSet(opts)
+}
+
+func (s *Service) RetrieveFundingParametersSetLimit(limit uint64) ([]*models.FundingParameters, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var params []*models.FundingParameters
+
+	logger.Log().WithField("layer", "Service-RetrieveFundingParametersSetLimit").Infof(
+		"fetching funding parameters with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveFundingParametersSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveFundingParametersSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveFundingParametersSetLimit").Infof("task completed successfully")
+
+	return params, nil
+}
+
+// retrieveFundingParametersSet is used to retrieve funding parameters with given filter options
+func (s *Service) retrieveFundingParametersSet(opts *bind.FilterOpts) ([]*models.FundingParameters, error) {
+	iterator, err := s.perpsMarket.FilterFundingParametersSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveFundingParametersSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var params []*models.FundingParameters
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveFundingParametersSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		param, err := s.getFundingParametersSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// getFundingParametersSet is used to get models.FundingParameters from given event and block number
+func (s *Service) getFundingParametersSet(event *perpsMarket.PerpsMarketFundingParametersSet, blockN uint64) (*models.FundingParameters, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveFundingParametersSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetFundingParametersFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.LiquidationParameters, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveLiquidationParametersSet(opts)
+}
+
+func (s *Service) RetrieveLiquidationParametersSetLimit(limit uint64) ([]*models.LiquidationParameters, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var params []*models.LiquidationParameters
+
+	logger.Log().WithField("layer", "Service-RetrieveLiquidationParametersSetLimit").Infof(
+		"fetching liquidation parameters with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveLiquidationParametersSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveLiquidationParametersSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveLiquidationParametersSetLimit").Infof("task completed successfully")
+
+	return params, nil
+}
+
+// retrieveLiquidationParametersSet is used to retrieve liquidation parameters with given filter options
+func (s *Service) retrieveLiquidationParametersSet(opts *bind.FilterOpts) ([]*models.LiquidationParameters, error) {
+	iterator, err := s.perpsMarket.FilterLiquidationParametersSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveLiquidationParametersSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var params []*models.LiquidationParameters
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveLiquidationParametersSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		param, err := s.getLiquidationParametersSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// getLiquidationParametersSet is used to get models.LiquidationParameters from given event and block number
+func (s *Service) getLiquidationParametersSet(event *perpsMarket.PerpsMarketLiquidationParametersSet, blockN uint64) (*models.LiquidationParameters, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveLiquidationParametersSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetLiquidationParametersFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveMaxLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxLiquidationParameters, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveMaxLiquidationParametersSet(opts)
+}
+
+func (s *Service) RetrieveMaxLiquidationParametersSetLimit(limit uint64) ([]*models.MaxLiquidationParameters, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var params []*models.MaxLiquidationParameters
+
+	logger.Log().WithField("layer", "Service-RetrieveMaxLiquidationParametersSetLimit").Infof(
+		"fetching max liquidation parameters with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMaxLiquidationParametersSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveMaxLiquidationParametersSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMaxLiquidationParametersSetLimit").Infof("task completed successfully")
+
+	return params, nil
+}
+
+// retrieveMaxLiquidationParametersSet is used to retrieve max liquidation parameters with given filter options
+func (s *Service) retrieveMaxLiquidationParametersSet(opts *bind.FilterOpts) ([]*models.MaxLiquidationParameters, error) {
+	iterator, err := s.perpsMarket.FilterMaxLiquidationParametersSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMaxLiquidationParametersSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var params []*models.MaxLiquidationParameters
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMaxLiquidationParametersSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		param, err := s.getMaxLiquidationParametersSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// getMaxLiquidationParametersSet is used to get models.MaxLiquidationParameters from given event and block number
+func (s *Service) getMaxLiquidationParametersSet(event *perpsMarket.PerpsMarketMaxLiquidationParametersSet, blockN uint64) (*models.MaxLiquidationParameters, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMaxLiquidationParametersSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetMaxLiquidationParametersFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveMaxMarketSizesSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxMarketSize, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveMaxMarketSizesSet(opts)
+}
+
+func (s *Service) RetrieveMaxMarketSizesSetLimit(limit uint64) ([]*models.MaxMarketSize, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var sizes []*models.MaxMarketSize
+
+	logger.Log().WithField("layer", "Service-RetrieveMaxMarketSizesSetLimit").Infof(
+		"fetching max market sizes with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMaxMarketSizesSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveMaxMarketSizesSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		sizes = append(sizes, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMaxMarketSizesSetLimit").Infof("task completed successfully")
+
+	return sizes, nil
+}
+
+// retrieveMaxMarketSizesSet is used to retrieve max market sizes with given filter options
+func (s *Service) retrieveMaxMarketSizesSet(opts *bind.FilterOpts) ([]*models.MaxMarketSize, error) {
+	iterator, err := s.perpsMarket.FilterMaxMarketSizeSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMaxMarketSizesSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var sizes []*models.MaxMarketSize
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMaxMarketSizesSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		size, err := s.getMaxMarketSizeSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		sizes = append(sizes, size)
+	}
+
+	// keep max market sizes ordered by block number, so the latest value per market is the last one in the slice
+	sort.SliceStable(sizes, func(i, j int) bool {
+		return sizes[i].BlockNumber < sizes[j].BlockNumber
+	})
+
+	return sizes, nil
+}
+
+// getMaxMarketSizeSet is used to get models.MaxMarketSize from given event and block number
+func (s *Service) getMaxMarketSizeSet(event *perpsMarket.PerpsMarketMaxMarketSizeSet, blockN uint64) (*models.MaxMarketSize, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMaxMarketSizesSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetMaxMarketSizeFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveOrderFeesSet(fromBlock uint64, toBLock *uint64) ([]*models.OrderFees, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveOrderFeesSet(opts)
+}
+
+func (s *Service) RetrieveOrderFeesSetLimit(limit uint64) ([]*models.OrderFees, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var fees []*models.OrderFees
+
+	logger.Log().WithField("layer", "Service-RetrieveOrderFeesSetLimit").Infof(
+		"fetching order fees with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveOrderFeesSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveOrderFeesSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		fees = append(fees, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveOrderFeesSetLimit").Infof("task completed successfully")
+
+	return fees, nil
+}
+
+// retrieveOrderFeesSet is used to retrieve order fees with given filter options
+func (s *Service) retrieveOrderFeesSet(opts *bind.FilterOpts) ([]*models.OrderFees, error) {
+	iterator, err := s.perpsMarket.FilterOrderFeesSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveOrderFeesSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var fees []*models.OrderFees
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveOrderFeesSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		fee, err := s.getOrderFeesSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		fees = append(fees, fee)
+	}
+
+	return fees, nil
+}
+
+// getOrderFeesSet is used to get models.OrderFees from given event and block number
+func (s *Service) getOrderFeesSet(event *perpsMarket.PerpsMarketOrderFeesSet, blockN uint64) (*models.OrderFees, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveOrderFeesSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetOrderFeesFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveMarketPriceDataUpdated(fromBlock uint64, toBLock *uint64) ([]*models.MarketPriceData, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveMarketPriceDataUpdated(opts)
+}
+
+func (s *Service) RetrieveMarketPriceDataUpdatedLimit(limit uint64) ([]*models.MarketPriceData, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := []*models.MarketPriceData{}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketPriceDataUpdatedLimit").Infof(
+		"fetching market price data updates with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMarketPriceDataUpdatedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveMarketPriceDataUpdated(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketPriceDataUpdatedLimit").Infof("task completed successfully")
+
+	return updates, nil
+}
+
+// retrieveMarketPriceDataUpdated is used to retrieve market price data updates with given filter options
+func (s *Service) retrieveMarketPriceDataUpdated(opts *bind.FilterOpts) ([]*models.MarketPriceData, error) {
+	iterator, err := s.perpsMarket.FilterMarketPriceDataUpdated(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketPriceDataUpdated").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	updates := []*models.MarketPriceData{}
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketPriceDataUpdated").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		update, err := s.getMarketPriceDataUpdated(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// getMarketPriceDataUpdated is used to get models.MarketPriceData from given event and block number
+func (s *Service) getMarketPriceDataUpdated(event *perpsMarket.PerpsMarketMarketPriceDataUpdated, blockN uint64) (*models.MarketPriceData, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketPriceDataUpdated").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetMarketPriceDataFromEvent(event, block.Time), nil
+}
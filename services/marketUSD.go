@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
@@ -12,7 +14,7 @@ import (
 )
 
 func (s *Service) RetrieveMarketUSDDepositedLimit(limit uint64) ([]*models.MarketUSDDeposited, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +94,7 @@ func (s *Service) getMarketUSDDeposited(event *core.CoreMarketUsdDeposited, bloc
 }
 
 func (s *Service) RetrieveMarketUSDWithdrawnLimit(limit uint64) ([]*models.MarketUSDWithdrawn, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -170,3 +172,230 @@ func (s *Service) getMarketUSDWithdrawn(event *core.CoreMarketUsdWithdrawn, bloc
 
 	return models.GetMarketUSDWithdrawnFromEvent(event, block.Time), nil
 }
+
+func (s *Service) RetrieveMarketsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.MarketRegistered, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveMarketsRegistered(opts)
+}
+
+func (s *Service) RetrieveMarketsRegisteredLimit(limit uint64) ([]*models.MarketRegistered, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	markets := []*models.MarketRegistered{}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketsRegisteredLimit").Infof(
+		"fetching registered markets with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMarketsRegisteredLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveMarketsRegistered(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		markets = append(markets, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketsRegisteredLimit").Infof("task completed successfully")
+
+	return markets, nil
+}
+
+// retrieveMarketsRegistered is used to retrieve registered markets with given filter options
+func (s *Service) retrieveMarketsRegistered(opts *bind.FilterOpts) ([]*models.MarketRegistered, error) {
+	iterator, err := s.core.FilterMarketRegistered(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketsRegistered").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	markets := []*models.MarketRegistered{}
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketsRegistered").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		market, err := s.getMarketRegistered(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		markets = append(markets, market)
+	}
+
+	return markets, nil
+}
+
+// getMarketRegistered is used to get models.MarketRegistered from given event and block number
+func (s *Service) getMarketRegistered(event *core.CoreMarketRegistered, blockN uint64) (*models.MarketRegistered, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketsRegistered").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetMarketRegisteredFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveMarketUSDChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketUSDChange, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveMarketUSDChanges(opts)
+}
+
+func (s *Service) RetrieveMarketUSDChangesLimit(limit uint64) ([]*models.MarketUSDChange, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*models.MarketUSDChange{}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketUSDChangesLimit").Infof(
+		"fetching market USD changes with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveMarketUSDChangesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrieveMarketUSDChanges(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveMarketUSDChangesLimit").Infof("task completed successfully")
+
+	return changes, nil
+}
+
+// retrieveMarketUSDChanges is used to retrieve "MarketUsdDeposited" and "MarketUsdWithdrawn" events with given filter
+// options merged to one deduplicated stream ordered by block number and log index
+func (s *Service) retrieveMarketUSDChanges(opts *bind.FilterOpts) ([]*models.MarketUSDChange, error) {
+	deposits, err := s.retrieveMarketUSDDeposits(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := s.retrieveMarketUSDWithdrawals(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*models.MarketUSDChange, 0, len(deposits)+len(withdrawals))
+	seen := make(map[string]struct{}, len(deposits)+len(withdrawals))
+	for _, change := range append(deposits, withdrawals...) {
+		// some RPC providers can return the same log twice, a log is unique by its transaction hash and log index
+		key := fmt.Sprintf("%v-%v", change.TransactionHash, change.LogIndex)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		changes = append(changes, change)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].BlockNumber != changes[j].BlockNumber {
+			return changes[i].BlockNumber < changes[j].BlockNumber
+		}
+
+		return changes[i].LogIndex < changes[j].LogIndex
+	})
+
+	return changes, nil
+}
+
+// retrieveMarketUSDDeposits is used to retrieve "MarketUsdDeposited" events as market USD changes
+// with given filter options
+func (s *Service) retrieveMarketUSDDeposits(opts *bind.FilterOpts) ([]*models.MarketUSDChange, error) {
+	iterator, err := s.core.FilterMarketUsdDeposited(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketUSDChanges").Errorf("error get deposited iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.MarketUSDChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketUSDChanges").Errorf("deposited iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetMarketUSDChangeFromDepositedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrieveMarketUSDWithdrawals is used to retrieve "MarketUsdWithdrawn" events as market USD
+// changes with given filter options
+func (s *Service) retrieveMarketUSDWithdrawals(opts *bind.FilterOpts) ([]*models.MarketUSDChange, error) {
+	iterator, err := s.core.FilterMarketUsdWithdrawn(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveMarketUSDChanges").Errorf("error get withdrawn iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.MarketUSDChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveMarketUSDChanges").Errorf("withdrawn iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetMarketUSDChangeFromWithdrawnEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
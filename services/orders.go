@@ -98,3 +98,192 @@ func (s *Service) getOrder(event *perpsMarket.PerpsMarketOrderCommitted, blockN
 
 	return models.GetOrderFromEvent(event, block.Time), nil
 }
+
+func (s *Service) RetrieveOrdersCanceled(fromBlock uint64, toBLock *uint64) ([]*models.OrderCanceled, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveOrdersCanceled(opts)
+}
+
+func (s *Service) RetrieveOrdersCanceledLimit(limit uint64) ([]*models.OrderCanceled, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*models.OrderCanceled
+
+	logger.Log().WithField("layer", "Service-RetrieveOrdersCanceledLimit").Infof(
+		"fetching canceled orders with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveOrdersCanceledLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveOrdersCanceled(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveOrdersCanceledLimit").Infof("task completed successfully")
+
+	return orders, nil
+}
+
+// retrieveOrdersCanceled is used to retrieve canceled orders with given filter options
+func (s *Service) retrieveOrdersCanceled(opts *bind.FilterOpts) ([]*models.OrderCanceled, error) {
+	iterator, err := s.perpsMarket.FilterOrderCancelled(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveOrdersCanceled").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var orders []*models.OrderCanceled
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveOrdersCanceled").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		order, err := s.getOrdersCanceled(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// getOrdersCanceled is used to get models.OrderCanceled from given event and block number
+func (s *Service) getOrdersCanceled(event *perpsMarket.PerpsMarketOrderCancelled, blockN uint64) (*models.OrderCanceled, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveOrdersCanceled").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetOrderCanceledFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrievePreviousOrderExpired(fromBlock uint64, toBLock *uint64) ([]*models.OrderExpired, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrievePreviousOrderExpired(opts)
+}
+
+func (s *Service) RetrievePreviousOrderExpiredLimit(limit uint64) ([]*models.OrderExpired, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*models.OrderExpired
+
+	logger.Log().WithField("layer", "Service-RetrievePreviousOrderExpiredLimit").Infof(
+		"fetching expired orders with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePreviousOrderExpiredLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrievePreviousOrderExpired(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePreviousOrderExpiredLimit").Infof("task completed successfully")
+
+	return orders, nil
+}
+
+// retrievePreviousOrderExpired is used to retrieve expired orders with given filter options
+func (s *Service) retrievePreviousOrderExpired(opts *bind.FilterOpts) ([]*models.OrderExpired, error) {
+	iterator, err := s.perpsMarket.FilterPreviousOrderExpired(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePreviousOrderExpired").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var orders []*models.OrderExpired
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePreviousOrderExpired").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		order, err := s.getPreviousOrderExpired(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// getPreviousOrderExpired is used to get models.OrderExpired from given event and block number
+func (s *Service) getPreviousOrderExpired(event *perpsMarket.PerpsMarketPreviousOrderExpired, blockN uint64) (*models.OrderExpired, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePreviousOrderExpired").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetOrderExpiredFromEvent(event, block.Time), nil
+}
+
+func (s *Service) GetPendingOrder(accountId *big.Int) (*models.PendingOrder, error) {
+	if accountId == nil {
+		logger.Log().WithField("layer", "Service-GetPendingOrder").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	order, err := s.perpsMarket.GetOrder(nil, accountId)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetPendingOrder").Errorf("get order error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "GetOrder")
+	}
+
+	return models.GetPendingOrderFromContract(order), nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 )
 
@@ -72,7 +73,7 @@ func TestService_RetrieveOrders_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 			res, err := s.RetrieveOrders(tt.startBlock, &tt.endBlock)
 
 			if err != nil {
@@ -107,9 +108,98 @@ func TestService_RetrieveOrders_OnChain_Limit(t *testing.T) {
 	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
 	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
 
-	s, _ := NewService(rpcClient, conf, coreC, perps)
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 	_, err := s.RetrieveOrdersLimit(20000)
 
 	require.NoError(t, err)
 }
+
+func TestService_RetrieveOrdersCanceled_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrieveOrdersCanceledLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrievePreviousOrderExpired_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePreviousOrderExpiredLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_GetPendingOrder(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	// max uint128 value is never reached by the perps account id counter, so it never has a pending order
+	noOrderID, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		want    *models.PendingOrder
+		wantErr error
+	}{
+		{
+			name:    "nil account id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "no pending order",
+			id:   noOrderID,
+			want: nil,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetPendingOrder(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
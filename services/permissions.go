@@ -0,0 +1,142 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) RetrievePermissionChanges(fromBlock uint64, toBLock *uint64) ([]*models.PermissionChanged, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrievePermissionChanges(opts)
+}
+
+func (s *Service) RetrievePermissionChangesLimit(limit uint64) ([]*models.PermissionChanged, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*models.PermissionChanged{}
+
+	logger.Log().WithField("layer", "Service-RetrievePermissionChangesLimit").Infof(
+		"fetching account permission changes with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePermissionChangesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrievePermissionChanges(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePermissionChangesLimit").Infof("task completed successfully")
+
+	return changes, nil
+}
+
+// retrievePermissionChanges is used to retrieve "PermissionGranted" and "PermissionRevoked" events with given filter
+// options merged to one stream ordered by block number and log index
+func (s *Service) retrievePermissionChanges(opts *bind.FilterOpts) ([]*models.PermissionChanged, error) {
+	granted, err := s.retrievePermissionsGranted(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.retrievePermissionsRevoked(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*models.PermissionChanged, 0, len(granted)+len(revoked))
+	changes = append(changes, granted...)
+	changes = append(changes, revoked...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].BlockNumber != changes[j].BlockNumber {
+			return changes[i].BlockNumber < changes[j].BlockNumber
+		}
+
+		return changes[i].LogIndex < changes[j].LogIndex
+	})
+
+	return changes, nil
+}
+
+// retrievePermissionsGranted is used to retrieve "PermissionGranted" events as permission changes with given filter
+// options. Events with unsupported permission values are kept with the raw permission value
+func (s *Service) retrievePermissionsGranted(opts *bind.FilterOpts) ([]*models.PermissionChanged, error) {
+	iterator, err := s.perpsMarket.FilterPermissionGranted(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePermissionChanges").Errorf("error get granted iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var changes []*models.PermissionChanged
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePermissionChanges").Errorf("granted iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetPermissionChangedFromGrantedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrievePermissionsRevoked is used to retrieve "PermissionRevoked" events as permission changes with given filter
+// options. Events with unsupported permission values are kept with the raw permission value
+func (s *Service) retrievePermissionsRevoked(opts *bind.FilterOpts) ([]*models.PermissionChanged, error) {
+	iterator, err := s.perpsMarket.FilterPermissionRevoked(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePermissionChanges").Errorf("error get revoked iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var changes []*models.PermissionChanged
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePermissionChanges").Errorf("revoked iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetPermissionChangedFromRevokedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
@@ -2,13 +2,13 @@ package services
 
 import (
 	"context"
-	"fmt"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/gateway-fm/perpsv3-Go/contracts/forwarder"
 	"math/big"
+	"sort"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/errors"
@@ -16,8 +16,13 @@ import (
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
 
+func (s *Service) RetrieveUSDMinted(fromBlock uint64, toBLock *uint64) ([]*models.USDMinted, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveUSDMinted(opts)
+}
+
 func (s *Service) RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +63,13 @@ func (s *Service) RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, err
 	return mints, nil
 }
 
+func (s *Service) RetrieveUSDBurned(fromBlock uint64, toBLock *uint64) ([]*models.USDBurned, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveUSDBurned(opts)
+}
+
 func (s *Service) RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +110,13 @@ func (s *Service) RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, err
 	return burns, nil
 }
 
+func (s *Service) RetrieveDelegationUpdated(fromBlock uint64, toBLock *uint64) ([]*models.DelegationUpdated, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveDelegationUpdated(opts)
+}
+
 func (s *Service) RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -257,66 +272,699 @@ func (s *Service) getDelegationUpdated(event *core.CoreDelegationUpdated, blockN
 	return models.GetDelegationUpdatedFromEvent(event, block.Time), nil
 }
 
-func (s *Service) GetVaultCollateral(poolID *big.Int, collateralType common.Address) (amount *big.Int, value *big.Int, err error) {
+func (s *Service) GetVaultCollateral(poolID *big.Int, collateralType common.Address) (*models.VaultCollateral, error) {
+	if poolID == nil {
+		logger.Log().WithField("layer", "Service-GetVaultCollateral").Errorf("received nil pool id")
+		return nil, errors.GetInvalidArgumentErr("pool id cannot be nil")
+	}
+
 	res, err := s.core.GetVaultCollateral(nil, poolID, collateralType)
 	if err != nil {
 		logger.Log().WithField("layer", "Service-GetVaultCollateral").Errorf("error from the contract: %v", err.Error())
-		return nil, nil, errors.GetReadContractErr(err, "core", "getVaultCollateral")
+		return nil, errors.GetReadContractErr(err, "core", "getVaultCollateral")
 	}
 
-	return res.Amount, res.Value, nil
+	return &models.VaultCollateral{
+		PoolID:         poolID,
+		CollateralType: collateralType,
+		Amount:         res.Amount,
+		Value:          res.Value,
+	}, nil
 }
 
 func (s *Service) GetVaultDebt(poolID *big.Int, collateralType common.Address) (*big.Int, error) {
 	if poolID == nil {
-		logger.Log().WithField("layer", "Service-GetGetVaultDebt").Errorf("received nil pool id")
+		logger.Log().WithField("layer", "Service-GetVaultDebt").Errorf("received nil pool id")
+		return nil, errors.GetInvalidArgumentErr("pool id cannot be nil")
+	}
+
+	callData, err := s.rawCore.GetCallDataVaultDebt(poolID, collateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.callCoreRetries(callData, "getVaultDebt", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rawCore.UnpackVaultDebt(data)
+}
+
+func (s *Service) GetVaultCollateralRatio(poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	if poolID == nil {
+		logger.Log().WithField("layer", "Service-GetVaultCollateralRatio").Errorf("received nil pool id")
+		return nil, errors.GetInvalidArgumentErr("pool id cannot be nil")
+	}
+
+	callData, err := s.rawCore.GetCallDataVaultCollateralRatio(poolID, collateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.callCoreRetries(callData, "getVaultCollateralRatio", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rawCore.UnpackVaultCollateralRatio(data)
+}
+
+func (s *Service) GetPositionCollateral(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*models.PositionCollateral, error) {
+	if accountID == nil || poolID == nil {
+		logger.Log().WithField("layer", "Service-GetPositionCollateral").Errorf("received nil account id or pool id")
+		return nil, errors.GetInvalidArgumentErr("account id and pool id cannot be nil")
+	}
+
+	callData, err := s.rawCore.GetCallDataPosition(accountID, poolID, collateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.callCoreRetries(callData, "getPosition", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := s.rawCore.UnpackPosition(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PositionCollateral{
+		AccountID:      accountID,
+		PoolID:         poolID,
+		CollateralType: collateralType,
+		Amount:         position.CollateralAmount,
+		Value:          position.CollateralValue,
+	}, nil
+}
+
+func (s *Service) GetPositionDebt(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*big.Int, error) {
+	if accountID == nil || poolID == nil {
+		logger.Log().WithField("layer", "Service-GetPositionDebt").Errorf("received nil account id or pool id")
+		return nil, errors.GetInvalidArgumentErr("account id and pool id cannot be nil")
+	}
+
+	callData, err := s.rawCore.GetCallDataPositionDebt(accountID, poolID, collateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.callCoreRetries(callData, "getPositionDebt", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rawCore.UnpackPositionDebt(data)
+}
+
+// callCoreRetries is used to execute given core call data with eth_call at the latest block with retries. Core getters
+// like `getVaultDebt` are not marked as view in the ABI, so they can not be called with the contract binding. Reverted
+// calls are deterministic and are not retried
+func (s *Service) callCoreRetries(callData []byte, method string, fails int) ([]byte, error) {
+	to := s.rawCore.Address()
+
+	res, err := s.coreCaller.CallContract(context.Background(), ethereum.CallMsg{To: &to, Data: callData}, nil)
+	if err != nil {
+		if !isExecutionReverted(err) && fails <= s.multicallRetries {
+			time.Sleep(s.multicallWait)
+			return s.callCoreRetries(callData, method, fails+1)
+		}
+
+		logger.Log().WithField("layer", "Service-callCoreRetries").Errorf("error calling %v: %v", method, err.Error())
+		return nil, errors.GetReadContractErr(err, "core", method)
+	}
+
+	return res, nil
+}
+
+func (s *Service) RetrievePoolsCreated(fromBlock uint64, toBLock *uint64) ([]*models.PoolCreated, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrievePoolsCreated(opts)
+}
+
+func (s *Service) RetrievePoolsCreatedLimit(limit uint64) ([]*models.PoolCreated, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []*models.PoolCreated
+
+	logger.Log().WithField("layer", "Service-RetrievePoolsCreatedLimit").Infof(
+		"fetching created pools with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePoolsCreatedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrievePoolsCreated(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePoolsCreatedLimit").Infof("task completed successfully")
+
+	return pools, nil
+}
+
+// retrievePoolsCreated is used to retrieve created pools with given filter options
+func (s *Service) retrievePoolsCreated(opts *bind.FilterOpts) ([]*models.PoolCreated, error) {
+	iterator, err := s.core.FilterPoolCreated(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolsCreated").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var pools []*models.PoolCreated
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolsCreated").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		pool, err := s.getPoolCreated(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+// getPoolCreated is used to get models.PoolCreated from given event and block number
+func (s *Service) getPoolCreated(event *core.CorePoolCreated, blockN uint64) (*models.PoolCreated, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolsCreated").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetPoolCreatedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) GetPoolConfiguration(poolID *big.Int) ([]*models.PoolMarketConfiguration, error) {
+	if poolID == nil {
+		logger.Log().WithField("layer", "Service-GetPoolConfiguration").Errorf("received nil pool id")
 		return nil, errors.GetInvalidArgumentErr("pool id cannot be nil")
 	}
-	return s.getVaultDebtRetries(poolID, collateralType, s.multicallRetries)
+
+	markets, err := s.core.GetPoolConfiguration(nil, poolID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetPoolConfiguration").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getPoolConfiguration")
+	}
+
+	return models.GetPoolMarketConfigurationsFromContract(markets), nil
 }
 
-func (s *Service) getVaultDebtRetries(poolID *big.Int, collateralType common.Address, fails int) (res *big.Int, err error) {
-	res, err = s.getVaultDebtMultiCallNoPyth(poolID, collateralType)
-	if err != nil && fails <= s.multicallRetries {
-		time.Sleep(s.multicallWait)
-		return s.getVaultDebtRetries(poolID, collateralType, fails+1)
+func (s *Service) GetPreferredPool() (*big.Int, error) {
+	poolID, err := s.core.GetPreferredPool(nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetPreferredPool").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getPreferredPool")
 	}
 
-	return res, err
+	return poolID, nil
 }
 
-func (s *Service) getVaultDebtMultiCallNoPyth(poolID *big.Int, collateralType common.Address) (res *big.Int, err error) {
-	getVaultDebtCallData, err := s.rawCore.GetCallDataVaultDebt(poolID, collateralType)
+func (s *Service) GetApprovedPools() ([]*big.Int, error) {
+	poolIDs, err := s.core.GetApprovedPools(nil)
 	if err != nil {
-		return res, err
+		logger.Log().WithField("layer", "Service-GetApprovedPools").Errorf("error from the contract: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "core", "getApprovedPools")
 	}
 
-	callVaultDebt := forwarder.TrustedMulticallForwarderCall3Value{
-		Target:         s.rawCore.Address(),
-		RequireSuccess: true,
-		Value:          big.NewInt(0),
-		CallData:       getVaultDebtCallData,
+	if poolIDs == nil {
+		poolIDs = []*big.Int{}
+	}
+
+	return poolIDs, nil
+}
+
+func (s *Service) GetPoolName(poolID *big.Int) (string, error) {
+	if poolID == nil {
+		logger.Log().WithField("layer", "Service-GetPoolName").Errorf("received nil pool id")
+		return "", errors.GetInvalidArgumentErr("pool id cannot be nil")
 	}
 
-	call, err := s.rawForwarder.Aggregate3Value(0, []forwarder.TrustedMulticallForwarderCall3Value{callVaultDebt})
+	name, err := s.core.GetPoolName(nil, poolID)
 	if err != nil {
-		return res, err
+		logger.Log().WithField("layer", "Service-GetPoolName").Errorf("error from the contract: %v", err.Error())
+		return "", errors.GetReadContractErr(err, "core", "getPoolName")
 	}
 
-	if len(call) != 1 {
-		logger.Log().WithField("layer", "getMarketSummaryMultiCallNoPyth").Errorf("received %v from rawForwarder contract, expected 1", len(call))
-		return res, errors.GetReadContractErr(fmt.Errorf("invalid call"), "rawForwarder", "Aggregate3Value")
+	// existing pools can have blank name as well, so blank name is checked against the pool owner
+	if name == "" {
+		if _, err = s.GetPoolOwner(poolID); err != nil {
+			return "", err
+		}
 	}
 
-	if !call[0].Success {
-		logger.Log().WithField("layer", "getMarketSummaryMultiCallNoPyth").Error("call to perps unsuccessful")
-		return res, errors.GetReadContractErr(fmt.Errorf("invalid call to perps"), "rawForwarder", "Aggregate3Value")
+	return name, nil
+}
+
+func (s *Service) GetPoolOwner(poolID *big.Int) (string, error) {
+	if poolID == nil {
+		logger.Log().WithField("layer", "Service-GetPoolOwner").Errorf("received nil pool id")
+		return "", errors.GetInvalidArgumentErr("pool id cannot be nil")
 	}
 
-	unpackedDebt, err := s.rawCore.UnpackVaultDebt(call[0].ReturnData)
+	owner, err := s.core.GetPoolOwner(nil, poolID)
 	if err != nil {
-		return res, err
+		logger.Log().WithField("layer", "Service-GetPoolOwner").Errorf("error from the contract: %v", err.Error())
+		return "", errors.GetReadContractErr(err, "core", "getPoolOwner")
+	}
+
+	// core returns blank owner for not existing pools instead of reverting
+	if owner == (common.Address{}) {
+		logger.Log().WithField("layer", "Service-GetPoolOwner").Errorf("pool %v not found", poolID.String())
+		return "", errors.PoolNotFoundErr
+	}
+
+	return owner.Hex(), nil
+}
+
+func (s *Service) GetNominatedPoolOwner(poolID *big.Int) (string, error) {
+	if poolID == nil {
+		logger.Log().WithField("layer", "Service-GetNominatedPoolOwner").Errorf("received nil pool id")
+		return "", errors.GetInvalidArgumentErr("pool id cannot be nil")
+	}
+
+	nominated, err := s.core.GetNominatedPoolOwner(nil, poolID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetNominatedPoolOwner").Errorf("error from the contract: %v", err.Error())
+		return "", errors.GetReadContractErr(err, "core", "getNominatedPoolOwner")
+	}
+
+	if nominated == (common.Address{}) {
+		if _, err = s.GetPoolOwner(poolID); err != nil {
+			return "", err
+		}
+
+		return "", nil
+	}
+
+	return nominated.Hex(), nil
+}
+
+func (s *Service) RetrievePoolConfigurationsSet(fromBlock uint64, toBLock *uint64) ([]*models.PoolConfigurationSet, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrievePoolConfigurationsSet(opts)
+}
+
+func (s *Service) RetrievePoolConfigurationsSetLimit(limit uint64) ([]*models.PoolConfigurationSet, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var configurations []*models.PoolConfigurationSet
+
+	logger.Log().WithField("layer", "Service-RetrievePoolConfigurationsSetLimit").Infof(
+		"fetching pool configurations with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePoolConfigurationsSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrievePoolConfigurationsSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		configurations = append(configurations, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePoolConfigurationsSetLimit").Infof("task completed successfully")
+
+	return configurations, nil
+}
+
+// retrievePoolConfigurationsSet is used to retrieve pool configurations with given filter options
+func (s *Service) retrievePoolConfigurationsSet(opts *bind.FilterOpts) ([]*models.PoolConfigurationSet, error) {
+	iterator, err := s.core.FilterPoolConfigurationSet(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolConfigurationsSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var configurations []*models.PoolConfigurationSet
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolConfigurationsSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		configuration, err := s.getPoolConfigurationSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations, nil
+}
+
+// getPoolConfigurationSet is used to get models.PoolConfigurationSet from given event and block number
+func (s *Service) getPoolConfigurationSet(event *core.CorePoolConfigurationSet, blockN uint64) (*models.PoolConfigurationSet, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolConfigurationsSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetPoolConfigurationSetFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrievePoolOwnershipChanges(fromBlock uint64, toBLock *uint64) ([]*models.PoolOwnershipChange, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrievePoolOwnershipChanges(opts)
+}
+
+func (s *Service) RetrievePoolOwnershipChangesLimit(limit uint64) ([]*models.PoolOwnershipChange, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*models.PoolOwnershipChange{}
+
+	logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChangesLimit").Infof(
+		"fetching pool ownership changes with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChangesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrievePoolOwnershipChanges(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChangesLimit").Infof("task completed successfully")
+
+	return changes, nil
+}
+
+// retrievePoolOwnershipChanges is used to retrieve "PoolOwnerNominated", "PoolOwnershipAccepted",
+// "PoolNominationRevoked" and "PoolNominationRenounced" events with given filter options merged to one stream ordered
+// by block number and log index
+func (s *Service) retrievePoolOwnershipChanges(opts *bind.FilterOpts) ([]*models.PoolOwnershipChange, error) {
+	changes := []*models.PoolOwnershipChange{}
+
+	nominated, err := s.retrievePoolOwnersNominated(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, nominated...)
+
+	accepted, err := s.retrievePoolOwnershipsAccepted(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, accepted...)
+
+	revoked, err := s.retrievePoolNominationsRevoked(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, revoked...)
+
+	renounced, err := s.retrievePoolNominationsRenounced(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes = append(changes, renounced...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].BlockNumber != changes[j].BlockNumber {
+			return changes[i].BlockNumber < changes[j].BlockNumber
+		}
+
+		return changes[i].LogIndex < changes[j].LogIndex
+	})
+
+	return changes, nil
+}
+
+// retrievePoolOwnersNominated is used to retrieve "PoolOwnerNominated" events as pool ownership changes with given
+// filter options
+func (s *Service) retrievePoolOwnersNominated(opts *bind.FilterOpts) ([]*models.PoolOwnershipChange, error) {
+	iterator, err := s.core.FilterPoolOwnerNominated(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("error get nominated iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.PoolOwnershipChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("nominated iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetPoolOwnershipChangeFromNominatedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrievePoolOwnershipsAccepted is used to retrieve "PoolOwnershipAccepted" events as pool ownership changes with
+// given filter options
+func (s *Service) retrievePoolOwnershipsAccepted(opts *bind.FilterOpts) ([]*models.PoolOwnershipChange, error) {
+	iterator, err := s.core.FilterPoolOwnershipAccepted(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("error get accepted iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.PoolOwnershipChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("accepted iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetPoolOwnershipChangeFromAcceptedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrievePoolNominationsRevoked is used to retrieve "PoolNominationRevoked" events as pool ownership changes with
+// given filter options
+func (s *Service) retrievePoolNominationsRevoked(opts *bind.FilterOpts) ([]*models.PoolOwnershipChange, error) {
+	iterator, err := s.core.FilterPoolNominationRevoked(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("error get revoked iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.PoolOwnershipChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("revoked iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetPoolOwnershipChangeFromRevokedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+// retrievePoolNominationsRenounced is used to retrieve "PoolNominationRenounced" events as pool ownership changes with
+// given filter options
+func (s *Service) retrievePoolNominationsRenounced(opts *bind.FilterOpts) ([]*models.PoolOwnershipChange, error) {
+	iterator, err := s.core.FilterPoolNominationRenounced(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("error get renounced iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var changes []*models.PoolOwnershipChange
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolOwnershipChanges").Errorf("renounced iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, models.GetPoolOwnershipChangeFromRenouncedEvent(iterator.Event, blockTime))
+	}
+
+	return changes, nil
+}
+
+func (s *Service) RetrievePoolNameUpdates(fromBlock uint64, toBLock *uint64) ([]*models.PoolNameUpdated, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrievePoolNameUpdates(opts)
+}
+
+func (s *Service) RetrievePoolNameUpdatesLimit(limit uint64) ([]*models.PoolNameUpdated, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []*models.PoolNameUpdated
+
+	logger.Log().WithField("layer", "Service-RetrievePoolNameUpdatesLimit").Infof(
+		"fetching pool name updates with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.coreFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrievePoolNameUpdatesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsCore(fromBlock, &toBlock)
+
+		res, err := s.retrievePoolNameUpdates(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrievePoolNameUpdatesLimit").Infof("task completed successfully")
+
+	return updates, nil
+}
+
+// retrievePoolNameUpdates is used to retrieve pool name updates with given filter options
+func (s *Service) retrievePoolNameUpdates(opts *bind.FilterOpts) ([]*models.PoolNameUpdated, error) {
+	iterator, err := s.core.FilterPoolNameUpdated(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolNameUpdates").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "core")
+	}
+
+	var updates []*models.PoolNameUpdated
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrievePoolNameUpdates").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "core")
+		}
+
+		update, err := s.getPoolNameUpdated(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// getPoolNameUpdated is used to get models.PoolNameUpdated from given event and block number
+func (s *Service) getPoolNameUpdated(event *core.CorePoolNameUpdated, blockN uint64) (*models.PoolNameUpdated, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrievePoolNameUpdates").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
 	}
 
-	return unpackedDebt, nil
+	return models.GetPoolNameUpdatedFromEvent(event, block.Time), nil
 }
@@ -0,0 +1,437 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/rawContracts"
+)
+
+func TestService_RetrievePoolsCreated_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePoolsCreatedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrievePoolConfigurationsSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePoolConfigurationsSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrievePoolOwnershipChanges_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePoolOwnershipChangesLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrievePoolNameUpdates_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	_, err := s.RetrievePoolNameUpdatesLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_GetVaultCollateral_RecordedCall(t *testing.T) {
+	// getVaultCollateral return data fixture for the vault with 1 500 collateral units valued at 3 000 000 USD
+	data := common.FromHex("0x" +
+		"00000000000000000000000000000000000000000000005150ae84a8cdf00000" +
+		"000000000000000000000000000000000000000000027b46536c66c8e3000000",
+	)
+
+	caller, err := core.NewCoreCaller(
+		common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+	collateralType := common.HexToAddress("0xC74eA762cF06c9151cE074E6a569a5945b6302E7")
+
+	res, err := s.GetVaultCollateral(big.NewInt(1), collateralType)
+
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), res.PoolID)
+	require.Equal(t, collateralType, res.CollateralType)
+	require.Equal(t, "1500000000000000000000", res.Amount.String())
+	require.Equal(t, "3000000000000000000000000", res.Value.String())
+
+	_, err = s.GetVaultCollateral(nil, collateralType)
+
+	require.ErrorIs(t, err, errors.InvalidArgumentErr)
+}
+
+func TestService_GetPositionDebt_RecordedCall(t *testing.T) {
+	rawCore, err := rawContracts.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	collateralType := common.HexToAddress("0xC74eA762cF06c9151cE074E6a569a5945b6302E7")
+
+	// getPositionDebt return data fixture for the position in 1 wei credit
+	credit := common.FromHex("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+	testCases := []struct {
+		name      string
+		data      []byte
+		err       error
+		want      *big.Int
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "credit",
+			data:      credit,
+			want:      big.NewInt(-1),
+			wantCalls: 1,
+		},
+		{
+			name: "reverted call is not retried",
+			err: &revertErr{
+				data: "0x0e296c98000000000000000000000000000000000000000000000000000000000000007b",
+			},
+			wantCalls: 1,
+			wantErr:   errors.ReadContractErr,
+		},
+		{
+			name:      "rpc error is retried",
+			err:       fmt.Errorf("connection refused"),
+			wantCalls: 5,
+			wantErr:   errors.ReadContractErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			caller := &countingCaller{data: tt.data, err: tt.err}
+
+			// no forwarder is set as on the chains without trusted multicall forwarder
+			s := &Service{rawCore: rawCore, coreCaller: caller, multicallRetries: 3}
+
+			res, err := s.GetPositionDebt(big.NewInt(1), big.NewInt(1), collateralType)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, 0, tt.want.Cmp(res))
+			} else {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+
+			require.Equal(t, tt.wantCalls, caller.calls)
+		})
+	}
+}
+
+func TestService_GetPositionCollateral_RecordedCall(t *testing.T) {
+	rawCore, err := rawContracts.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	amount, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	value, _ := new(big.Int).SetString("2500000000000000000000", 10)
+
+	data, err := coreABI.Methods["getPosition"].Outputs.Pack(amount, value, big.NewInt(0), big.NewInt(0))
+	require.NoError(t, err)
+
+	collateralType := common.HexToAddress("0xC74eA762cF06c9151cE074E6a569a5945b6302E7")
+
+	s := &Service{rawCore: rawCore, coreCaller: &recordedCaller{data: data}}
+
+	res, err := s.GetPositionCollateral(big.NewInt(1), big.NewInt(1), collateralType)
+
+	require.NoError(t, err)
+	require.Equal(t, collateralType, res.CollateralType)
+	require.Equal(t, amount.String(), res.Amount.String())
+	require.Equal(t, value.String(), res.Value.String())
+}
+
+func TestService_GetVaultCollateralRatio_NoForwarder(t *testing.T) {
+	rawCore, err := rawContracts.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), nil)
+	require.NoError(t, err)
+
+	// getVaultCollateralRatio return data fixture for the vault with 525% collateralization ratio
+	data := common.LeftPadBytes(common.FromHex("0x48dbbf2f2ecd0000"), 32)
+
+	// forwarder is not set on the chains without trusted multicall forwarder, e.g. OptimismGoerli
+	s := &Service{rawCore: rawCore, coreCaller: &recordedCaller{data: data}}
+
+	res, err := s.GetVaultCollateralRatio(big.NewInt(1), common.HexToAddress("0xC74eA762cF06c9151cE074E6a569a5945b6302E7"))
+
+	require.NoError(t, err)
+	require.Equal(t, "5250000000000000000", res.String())
+}
+
+func TestService_GetPoolConfiguration_RecordedCall(t *testing.T) {
+	// getPoolConfiguration return data fixture for the pool without configured markets
+	data := common.FromHex("0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000000",
+	)
+
+	caller, err := core.NewCoreCaller(
+		common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+	res, err := s.GetPoolConfiguration(big.NewInt(1))
+
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Empty(t, res)
+
+	_, err = s.GetPoolConfiguration(nil)
+
+	require.ErrorIs(t, err, errors.InvalidArgumentErr)
+}
+
+func TestService_GetApprovedPools_RecordedCall(t *testing.T) {
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		pools []*big.Int
+		want  []*big.Int
+	}{
+		{
+			name:  "approved pools",
+			pools: []*big.Int{big.NewInt(1), big.NewInt(3)},
+			want:  []*big.Int{big.NewInt(1), big.NewInt(3)},
+		},
+		{
+			name:  "no approved pools",
+			pools: []*big.Int{},
+			want:  []*big.Int{},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := coreABI.Methods["getApprovedPools"].Outputs.Pack(tt.pools)
+			require.NoError(t, err)
+
+			caller, err := core.NewCoreCaller(
+				common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				&recordedCaller{data: data},
+			)
+			require.NoError(t, err)
+
+			s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+			res, err := s.GetApprovedPools()
+
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Len(t, res, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].String(), res[i].String())
+			}
+		})
+	}
+}
+
+func TestService_GetPreferredPool_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	preferred, err := s.GetPreferredPool()
+	require.NoError(t, err)
+	require.Equal(t, 1, preferred.Sign())
+
+	_, err = s.GetApprovedPools()
+	require.NoError(t, err)
+}
+
+func TestService_GetPoolName_RecordedCall(t *testing.T) {
+	coreABI, err := core.CoreMetaData.GetAbi()
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0xF9F6FDb6F9a2d1A5c1ECC2D6F7080E1b6D7B4c6d")
+
+	pack := func(method string, value interface{}) []byte {
+		data, err := coreABI.Methods[method].Outputs.Pack(value)
+		require.NoError(t, err)
+		return data
+	}
+
+	testCases := []struct {
+		name          string
+		poolID        *big.Int
+		poolName      string
+		owner         common.Address
+		nominated     common.Address
+		wantName      string
+		wantNominated string
+		wantErr       error
+	}{
+		{
+			name:    "nil pool id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:          "named pool with nominated owner",
+			poolID:        big.NewInt(1),
+			poolName:      "Spartan Council Pool",
+			owner:         owner,
+			nominated:     common.HexToAddress("0x0000000000000000000000000000000000000001"),
+			wantName:      "Spartan Council Pool",
+			wantNominated: "0x0000000000000000000000000000000000000001",
+		},
+		{
+			name:   "unnamed pool without nominated owner",
+			poolID: big.NewInt(2),
+			owner:  owner,
+		},
+		{
+			name:    "not existing pool",
+			poolID:  big.NewInt(1000),
+			wantErr: errors.PoolNotFoundErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := core.NewCoreCaller(
+				common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"),
+				&selectorCaller{data: map[string][]byte{
+					hexutil.Encode(coreABI.Methods["getPoolName"].ID):           pack("getPoolName", tt.poolName),
+					hexutil.Encode(coreABI.Methods["getPoolOwner"].ID):          pack("getPoolOwner", tt.owner),
+					hexutil.Encode(coreABI.Methods["getNominatedPoolOwner"].ID): pack("getNominatedPoolOwner", tt.nominated),
+				}},
+			)
+			require.NoError(t, err)
+
+			s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+			name, err := s.GetPoolName(tt.poolID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				_, err = s.GetPoolOwner(tt.poolID)
+				require.ErrorIs(t, err, tt.wantErr)
+
+				_, err = s.GetNominatedPoolOwner(tt.poolID)
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantName, name)
+
+			res, err := s.GetPoolOwner(tt.poolID)
+			require.NoError(t, err)
+			require.Equal(t, owner.Hex(), res)
+
+			nominated, err := s.GetNominatedPoolOwner(tt.poolID)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantNominated, nominated)
+		})
+	}
+}
+
+func TestService_GetPoolOwner_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	owner, err := s.GetPoolOwner(big.NewInt(1))
+	require.NoError(t, err)
+	require.True(t, common.IsHexAddress(owner))
+
+	_, err = s.GetPoolName(big.NewInt(1))
+	require.NoError(t, err)
+
+	_, err = s.GetPoolName(big.NewInt(1000000))
+	require.ErrorIs(t, err, errors.PoolNotFoundErr)
+	require.ErrorIs(t, err, errors.NotFoundErr)
+}
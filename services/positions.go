@@ -17,14 +17,73 @@ import (
 )
 
 func (s *Service) GetPosition(accountID *big.Int, marketID *big.Int) (*models.Position, error) {
-	var err error
+	opts, block, err := s.getLatestBlockCallOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := s.getPositionMultiCallRetries(opts, accountID, marketID, block, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	position.MarketID = marketID
+
+	return position, nil
+}
+
+func (s *Service) GetAccountOpenPositions(accountID *big.Int) ([]*models.Position, error) {
+	if accountID == nil {
+		logger.Log().WithField("layer", "Service-GetAccountOpenPositions").Errorf("received nil account id")
+		return nil, errors.GetInvalidArgumentErr("account id cannot be nil")
+	}
+
+	marketIDs, err := s.perpsMarket.GetAccountOpenPositions(nil, accountID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetAccountOpenPositions").Errorf(
+			"get account open positions error: %v", err.Error(),
+		)
+		return nil, errors.GetReadContractErr(err, "PerpsMarket", "getAccountOpenPositions")
+	}
 
+	opts, block, err := s.getLatestBlockCallOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	// block is used as the position data block. Multicall based chains always call the latest block, so positions can
+	// be fetched from different blocks if new blocks are produced while fetching
+	fetched := make([]*models.Position, len(marketIDs))
+	errs := runBatch(len(marketIDs), func(i int) (err error) {
+		fetched[i], err = s.getPositionMultiCallRetries(opts, accountID, marketIDs[i], block, 0)
+		return err
+	})
+
+	positions := []*models.Position{}
+	for i, position := range fetched {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+
+		if position.PositionSize == nil || position.PositionSize.Sign() == 0 {
+			continue
+		}
+
+		position.MarketID = marketIDs[i]
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
+
+// getLatestBlockCallOpts is used to get call options and header of the latest block
+func (s *Service) getLatestBlockCallOpts() (*bind.CallOpts, *types.Header, error) {
 	latest, err := s.rpcClient.BlockNumber(context.Background())
 	if err != nil {
 		logger.Log().WithField("layer", "Service-GetPositions").Errorf(
 			"error get latest block: %v", err.Error(),
 		)
-		return nil, errors.GetRPCProviderErr(err, "BlockNumber")
+		return nil, nil, errors.GetRPCProviderErr(err, "BlockNumber")
 	}
 
 	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(latest)))
@@ -32,12 +91,10 @@ func (s *Service) GetPosition(accountID *big.Int, marketID *big.Int) (*models.Po
 		logger.Log().WithField("layer", "Service-GetPositions").Errorf(
 			"get block by numer: %v error: %v", latest, err.Error(),
 		)
-		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+		return nil, nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
 	}
 
-	opts := &bind.CallOpts{BlockNumber: big.NewInt(int64(latest))}
-
-	return s.getPositionMultiCallRetries(opts, accountID, marketID, block, 0)
+	return &bind.CallOpts{BlockNumber: big.NewInt(int64(latest))}, block, nil
 }
 
 func (s *Service) getPositionMultiCallRetries(opts *bind.CallOpts, accountID *big.Int, marketID *big.Int, block *types.Header, fails int) (res *models.Position, err error) {
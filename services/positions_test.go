@@ -55,7 +55,7 @@ func TestService_GetPosition_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 			res, err := s.GetPosition(tt.accountID, tt.marketID)
 
@@ -72,3 +72,53 @@ func TestService_GetPosition_OnChain(t *testing.T) {
 		})
 	}
 }
+
+func TestService_GetAccountOpenPositions_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	id := new(big.Int)
+	id.SetString("170141183460469231731687303715884105754", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil account id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "account",
+			id:   id,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+			res, err := s.GetAccountOpenPositions(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				for _, p := range res {
+					require.NotNil(t, p.MarketID)
+					require.NotEqual(t, 0, p.PositionSize.Sign())
+				}
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -11,8 +11,13 @@ import (
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
 )
 
+func (s *Service) RetrieveRewardClaimed(fromBlock uint64, toBLock *uint64) ([]*models.RewardClaimed, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveRewardClaimed(opts)
+}
+
 func (s *Service) RetrieveRewardClaimedLimit(limit uint64) ([]*models.RewardClaimed, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -92,8 +97,13 @@ func (s *Service) getRewardClaimed(event *core.CoreRewardsClaimed, blockN uint64
 	return models.GetRewardClaimedFromEvent(event, block.Time), nil
 }
 
+func (s *Service) RetrieveRewardDistributed(fromBlock uint64, toBLock *uint64) ([]*models.RewardDistributed, error) {
+	opts := s.getFilterOptsCore(fromBlock, toBLock)
+	return s.retrieveRewardDistributed(opts)
+}
+
 func (s *Service) RetrieveRewardDistributedLimit(limit uint64) ([]*models.RewardDistributed, error) {
-	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.coreFirstBlock)
 	if err != nil {
 		return nil, err
 	}
@@ -3,15 +3,21 @@ package services
 import (
 	"context"
 	"math/big"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/gateway-fm/perpsv3-Go/config"
 	"github.com/gateway-fm/perpsv3-Go/contracts/core"
 	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
 	"github.com/gateway-fm/perpsv3-Go/errors"
 	"github.com/gateway-fm/perpsv3-Go/models"
 	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
@@ -62,30 +68,51 @@ type IService interface {
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveAccountLiquidationsLimit(limit uint64) ([]*models.AccountLiquidated, error)
 
+	// RetrieveUSDMinted is used to get all `UsdMinted` events from the Core contract within given block range
+	RetrieveUSDMinted(fromBlock uint64, toBLock *uint64) ([]*models.USDMinted, error)
+
 	// RetrieveUSDMintedLimit is used to get all `usdMinted` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveUSDMintedLimit(limit uint64) ([]*models.USDMinted, error)
 
+	// RetrieveUSDBurned is used to get all `UsdBurned` events from the Core contract within given block range
+	RetrieveUSDBurned(fromBlock uint64, toBLock *uint64) ([]*models.USDBurned, error)
+
 	// RetrieveUSDBurnedLimit is used to get all `usdBurned` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveUSDBurnedLimit(limit uint64) ([]*models.USDBurned, error)
 
+	// RetrieveDelegationUpdated is used to get all `DelegationUpdated` events from the Core contract within given block range
+	RetrieveDelegationUpdated(fromBlock uint64, toBLock *uint64) ([]*models.DelegationUpdated, error)
+
 	// RetrieveDelegationUpdatedLimit is used to get all `DelegationUpdated` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveDelegationUpdatedLimit(limit uint64) ([]*models.DelegationUpdated, error)
 
+	// RetrieveCollateralWithdrawn is used to get all `Withdrawn` events from the Core contract within given block range
+	RetrieveCollateralWithdrawn(fromBlock uint64, toBLock *uint64) ([]*models.CollateralWithdrawn, error)
+
 	// RetrieveCollateralWithdrawnLimit is used to get all `Withdrawn` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveCollateralWithdrawnLimit(limit uint64) ([]*models.CollateralWithdrawn, error)
 
+	// RetrieveCollateralDeposited is used to get all `Deposited` events from the Core contract within given block range
+	RetrieveCollateralDeposited(fromBlock uint64, toBLock *uint64) ([]*models.CollateralDeposited, error)
+
 	// RetrieveCollateralDepositedLimit is used to get all `Deposited` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveCollateralDepositedLimit(limit uint64) ([]*models.CollateralDeposited, error)
 
+	// RetrieveRewardClaimed is used to get all `RewardsClaimed` events from the Core contract within given block range
+	RetrieveRewardClaimed(fromBlock uint64, toBLock *uint64) ([]*models.RewardClaimed, error)
+
 	// RetrieveRewardClaimedLimit is used to get all `RewardClaimed` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveRewardClaimedLimit(limit uint64) ([]*models.RewardClaimed, error)
 
+	// RetrieveRewardDistributed is used to get all `RewardsDistributed` events from the Core contract within given block range
+	RetrieveRewardDistributed(fromBlock uint64, toBLock *uint64) ([]*models.RewardDistributed, error)
+
 	// RetrieveRewardDistributedLimit is used to get all `RewardDistributed` events from the Core contract with given block search
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveRewardDistributedLimit(limit uint64) ([]*models.RewardDistributed, error)
@@ -98,9 +125,381 @@ type IService interface {
 	// limit. For most public RPC providers the value for limit is 20 000 blocks
 	RetrieveMarketUSDWithdrawnLimit(limit uint64) ([]*models.MarketUSDWithdrawn, error)
 
+	// RetrieveOrdersCanceled is used to get logs from the "OrderCancelled" event preps market contract within given block
+	// range
+	RetrieveOrdersCanceled(fromBlock uint64, toBLock *uint64) ([]*models.OrderCanceled, error)
+
+	// RetrieveOrdersCanceledLimit is used to get all canceled orders and their additional data from the contract with given block
+	// search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveOrdersCanceledLimit(limit uint64) ([]*models.OrderCanceled, error)
+
+	// RetrievePreviousOrderExpired is used to get logs from the "PreviousOrderExpired" event preps market contract within
+	// given block range
+	RetrievePreviousOrderExpired(fromBlock uint64, toBLock *uint64) ([]*models.OrderExpired, error)
+
+	// RetrievePreviousOrderExpiredLimit is used to get all expired orders and their additional data from the contract with
+	// given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrievePreviousOrderExpiredLimit(limit uint64) ([]*models.OrderExpired, error)
+
+	// RetrieveCollateralModified is used to get logs from the "CollateralModified" event preps market contract within
+	// given block range
+	RetrieveCollateralModified(fromBlock uint64, toBLock *uint64) ([]*models.CollateralModified, error)
+
+	// RetrieveCollateralModifiedLimit is used to get all margin modifications and their additional data from the contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveCollateralModifiedLimit(limit uint64) ([]*models.CollateralModified, error)
+
+	// RetrieveAccountsCreated is used to get logs from the "AccountCreated" event preps market contract within given
+	// block range
+	RetrieveAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.AccountCreated, error)
+
+	// RetrieveAccountsCreatedLimit is used to get all created accounts and their additional data from the contract with
+	// given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveAccountsCreatedLimit(limit uint64) ([]*models.AccountCreated, error)
+
+	// RetrieveAccountLiquidationAttempts is used to get logs from the "AccountLiquidationAttempt" event preps market
+	// contract within given block range
+	RetrieveAccountLiquidationAttempts(fromBlock uint64, toBLock *uint64) ([]*models.AccountLiquidationAttempt, error)
+
+	// RetrieveAccountLiquidationAttemptsLimit is used to get all account liquidation attempts and their additional data
+	// from the contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveAccountLiquidationAttemptsLimit(limit uint64) ([]*models.AccountLiquidationAttempt, error)
+
+	// RetrieveSettlementStrategiesAdded is used to get logs from the "SettlementStrategyAdded" event preps market
+	// contract within given block range
+	RetrieveSettlementStrategiesAdded(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyAdded, error)
+
+	// RetrieveSettlementStrategiesAddedLimit is used to get all added settlement strategies and their additional data
+	// from the contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSettlementStrategiesAddedLimit(limit uint64) ([]*models.SettlementStrategyAdded, error)
+
+	// RetrieveSettlementStrategyUpdates is used to get logs from the "SettlementStrategySet" event preps market
+	// contract within given block range. Result is ordered by block number
+	RetrieveSettlementStrategyUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyUpdate, error)
+
+	// RetrieveSettlementStrategyUpdatesLimit is used to get all settlement strategy updates and their additional data
+	// from the contract with given block search limit. Result is ordered by block number. For most public RPC providers
+	// the value for limit is 20 000 blocks
+	RetrieveSettlementStrategyUpdatesLimit(limit uint64) ([]*models.SettlementStrategyUpdate, error)
+
+	// RetrieveFundingParametersSet is used to get logs from the "FundingParametersSet" event preps market contract
+	// within given block range
+	RetrieveFundingParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.FundingParameters, error)
+
+	// RetrieveFundingParametersSetLimit is used to get all funding parameters changes and their additional data from the
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveFundingParametersSetLimit(limit uint64) ([]*models.FundingParameters, error)
+
+	// RetrieveMarketsCreated is used to get logs from the "MarketCreated" event preps market contract within given
+	// block range
+	RetrieveMarketsCreated(fromBlock uint64, toBLock *uint64) ([]*models.MarketCreated, error)
+
+	// RetrieveMarketsCreatedLimit is used to get all created markets and their additional data from the contract with
+	// given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveMarketsCreatedLimit(limit uint64) ([]*models.MarketCreated, error)
+
+	// RetrieveLiquidationParametersSet is used to get logs from the "LiquidationParametersSet" event preps market
+	// contract within given block range
+	RetrieveLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.LiquidationParameters, error)
+
+	// RetrieveLiquidationParametersSetLimit is used to get all liquidation parameters changes and their additional data
+	// from the contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveLiquidationParametersSetLimit(limit uint64) ([]*models.LiquidationParameters, error)
+
+	// RetrieveMaxLiquidationParametersSet is used to get logs from the "MaxLiquidationParametersSet" event preps market
+	// contract within given block range
+	RetrieveMaxLiquidationParametersSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxLiquidationParameters, error)
+
+	// RetrieveMaxLiquidationParametersSetLimit is used to get all max liquidation parameters changes and their
+	// additional data from the contract with given block search limit. For most public RPC providers the value for limit
+	// is 20 000 blocks
+	RetrieveMaxLiquidationParametersSetLimit(limit uint64) ([]*models.MaxLiquidationParameters, error)
+
+	// RetrieveMaxMarketSizesSet is used to get logs from the "MaxMarketSizeSet" event preps market contract within
+	// given block range. Result is ordered by block number
+	RetrieveMaxMarketSizesSet(fromBlock uint64, toBLock *uint64) ([]*models.MaxMarketSize, error)
+
+	// RetrieveMaxMarketSizesSetLimit is used to get all max market size changes and their additional data from the
+	// contract with given block search limit. Result is ordered by block number. For most public RPC providers the value
+	// for limit is 20 000 blocks
+	RetrieveMaxMarketSizesSetLimit(limit uint64) ([]*models.MaxMarketSize, error)
+
+	// RetrieveOrderFeesSet is used to get logs from the "OrderFeesSet" event preps market contract within given block
+	// range
+	RetrieveOrderFeesSet(fromBlock uint64, toBLock *uint64) ([]*models.OrderFees, error)
+
+	// RetrieveOrderFeesSetLimit is used to get all order fees changes and their additional data from the contract with
+	// given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveOrderFeesSetLimit(limit uint64) ([]*models.OrderFees, error)
+
+	// RetrieveMarketPriceDataUpdated is used to get logs from the "MarketPriceDataUpdated" event preps market contract
+	// within given block range
+	RetrieveMarketPriceDataUpdated(fromBlock uint64, toBLock *uint64) ([]*models.MarketPriceData, error)
+
+	// RetrieveMarketPriceDataUpdatedLimit is used to get all market price data updates and their additional data from the
+	// contract with given block search limit. Returns empty slice if there are no updates. For most public RPC providers
+	// the value for limit is 20 000 blocks
+	RetrieveMarketPriceDataUpdatedLimit(limit uint64) ([]*models.MarketPriceData, error)
+
+	// RetrieveKeeperRewardGuardsSet is used to get logs from the "KeeperRewardGuardsSet" event preps market contract
+	// within given block range
+	RetrieveKeeperRewardGuardsSet(fromBlock uint64, toBLock *uint64) ([]*models.KeeperRewardGuards, error)
+
+	// RetrieveKeeperRewardGuardsSetLimit is used to get all keeper reward guards changes and their additional data from
+	// the contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveKeeperRewardGuardsSetLimit(limit uint64) ([]*models.KeeperRewardGuards, error)
+
+	// RetrievePerAccountCapsSet is used to get logs from the "PerAccountCapsSet" event preps market contract within
+	// given block range
+	RetrievePerAccountCapsSet(fromBlock uint64, toBLock *uint64) ([]*models.PerAccountCaps, error)
+
+	// RetrievePerAccountCapsSetLimit is used to get all per account caps changes and their additional data from the
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrievePerAccountCapsSetLimit(limit uint64) ([]*models.PerAccountCaps, error)
+
+	// RetrievePerpsCollateralConfigured is used to get logs from the "CollateralConfigurationSet" event preps market
+	// contract within given block range
+	RetrievePerpsCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.PerpsCollateralConfig, error)
+
+	// RetrievePerpsCollateralConfiguredLimit is used to get all perps collateral configurations and their additional data
+	// from the contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrievePerpsCollateralConfiguredLimit(limit uint64) ([]*models.PerpsCollateralConfig, error)
+
+	// RetrieveFeeCollectorSet is used to get logs from the "FeeCollectorSet" event preps market contract within given
+	// block range
+	RetrieveFeeCollectorSet(fromBlock uint64, toBLock *uint64) ([]*models.FeeCollector, error)
+
+	// RetrieveFeeCollectorSetLimit is used to get all fee collector changes and their additional data from the contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveFeeCollectorSetLimit(limit uint64) ([]*models.FeeCollector, error)
+
+	// RetrieveReferrerSharesUpdated is used to get logs from the "ReferrerShareUpdated" event preps market contract
+	// within given block range
+	RetrieveReferrerSharesUpdated(fromBlock uint64, toBLock *uint64) ([]*models.ReferrerShare, error)
+
+	// RetrieveReferrerSharesUpdatedLimit is used to get all referrer share changes and their additional data from the
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveReferrerSharesUpdatedLimit(limit uint64) ([]*models.ReferrerShare, error)
+
+	// RetrieveSynthsBought is used to get logs from the "SynthBought" event spot market contract within given block
+	// range
+	RetrieveSynthsBought(fromBlock uint64, toBLock *uint64) ([]*models.SynthBought, error)
+
+	// RetrieveSynthsBoughtLimit is used to get all bought synths and their additional data from the spot market contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSynthsBoughtLimit(limit uint64) ([]*models.SynthBought, error)
+
+	// RetrieveSynthsSold is used to get logs from the "SynthSold" event spot market contract within given block range
+	RetrieveSynthsSold(fromBlock uint64, toBLock *uint64) ([]*models.SynthSold, error)
+
+	// RetrieveSynthsSoldLimit is used to get all sold synths and their additional data from the spot market contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSynthsSoldLimit(limit uint64) ([]*models.SynthSold, error)
+
+	// RetrieveSynthsWrapped is used to get logs from the "SynthWrapped" event spot market contract within given block
+	// range
+	RetrieveSynthsWrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthWrapped, error)
+
+	// RetrieveSynthsWrappedLimit is used to get all wrapped synths and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSynthsWrappedLimit(limit uint64) ([]*models.SynthWrapped, error)
+
+	// RetrieveSynthsUnwrapped is used to get logs from the "SynthUnwrapped" event spot market contract within given block
+	// range
+	RetrieveSynthsUnwrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthUnwrapped, error)
+
+	// RetrieveSynthsUnwrappedLimit is used to get all unwrapped synths and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSynthsUnwrappedLimit(limit uint64) ([]*models.SynthUnwrapped, error)
+
+	// RetrieveSpotOrdersCommitted is used to get logs from the "OrderCommitted" event spot market contract within given block
+	// range
+	RetrieveSpotOrdersCommitted(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCommitted, error)
+
+	// RetrieveSpotOrdersCommittedLimit is used to get all committed spot orders and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSpotOrdersCommittedLimit(limit uint64) ([]*models.SpotOrderCommitted, error)
+
+	// RetrieveSpotOrdersSettled is used to get logs from the "OrderSettled" event spot market contract within given block
+	// range
+	RetrieveSpotOrdersSettled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderSettled, error)
+
+	// RetrieveSpotOrdersSettledLimit is used to get all settled spot orders and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSpotOrdersSettledLimit(limit uint64) ([]*models.SpotOrderSettled, error)
+
+	// RetrieveSpotOrdersCancelled is used to get logs from the "OrderCancelled" event spot market contract within given block
+	// range
+	RetrieveSpotOrdersCancelled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCancelled, error)
+
+	// RetrieveSpotOrdersCancelledLimit is used to get all cancelled spot orders and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSpotOrdersCancelledLimit(limit uint64) ([]*models.SpotOrderCancelled, error)
+
+	// RetrieveSynthsRegistered is used to get logs from the "SynthRegistered" event spot market contract within given block
+	// range
+	RetrieveSynthsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.SynthRegistered, error)
+
+	// RetrieveSynthsRegisteredLimit is used to get all registered synths and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSynthsRegisteredLimit(limit uint64) ([]*models.SynthRegistered, error)
+
+	// RetrieveWrappersSet is used to get logs from the "WrapperSet" event spot market contract within given block
+	// range
+	RetrieveWrappersSet(fromBlock uint64, toBLock *uint64) ([]*models.WrapperSet, error)
+
+	// RetrieveWrappersSetLimit is used to get all wrapper configurations and their additional data from the spot market
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveWrappersSetLimit(limit uint64) ([]*models.WrapperSet, error)
+
+	// RetrieveSpotMarketFeeUpdates is used to get logs from the "AtomicFixedFeeSet", "AsyncFixedFeeSet",
+	// "MarketUtilizationFeesSet" and "MarketSkewScaleSet" events spot market contract within given block range. Events are
+	// normalized to models.SpotFeeUpdate and sorted by block number
+	RetrieveSpotMarketFeeUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SpotFeeUpdate, error)
+
+	// RetrieveSpotMarketFeeUpdatesLimit is used to get all spot market fee updates sorted by block number from the spot
+	// market contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveSpotMarketFeeUpdatesLimit(limit uint64) ([]*models.SpotFeeUpdate, error)
+
+	// GetSynthAddress is used to get checksummed ERC-20 token address of the synth for given synth market ID. Returns
+	// NotFoundErr if no synth is registered for given market ID
+	GetSynthAddress(synthMarketID *big.Int) (string, error)
+
+	// GetSpotMarketFees is used to get current fee configuration of given synth market ID from the spot market contract.
+	// Returned struct holds the same values as the RetrieveSpotMarketFeeUpdates events plus wrap and unwrap fees
+	GetSpotMarketFees(synthMarketID *big.Int) (*models.SpotMarketFees, error)
+
+	// GetSpotSettlementStrategy is used to get spot market async order settlement strategy parameters by given synth
+	// market ID and strategy ID. Use SpotSettlementStrategy.FeedIDHex to get the price feed ID as a hex string
+	GetSpotSettlementStrategy(synthMarketID *big.Int, strategyID *big.Int) (*models.SpotSettlementStrategy, error)
+
+	// GetQuoteBuyExactIn is used to get synth amount and fees breakdown of buying synth of given synth market ID for
+	// exactly given USD amount. Returns OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetQuoteBuyExactIn(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error)
+
+	// GetQuoteBuyExactOut is used to get USD amount charged and fees breakdown of buying exactly given synth amount of
+	// given synth market ID. Returns OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetQuoteBuyExactOut(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error)
+
+	// GetQuoteSellExactIn is used to get USD amount returned and fees breakdown of selling exactly given synth amount of
+	// given synth market ID. Returns OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetQuoteSellExactIn(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error)
+
+	// GetQuoteSellExactOut is used to get synth amount to burn and fees breakdown of selling synth of given synth market
+	// ID for exactly given USD amount. Returns OracleDataRequiredErr if the call reverted with ERC7412
+	// `OracleDataRequired` error
+	GetQuoteSellExactOut(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error)
+
+	// RetrieveCoreAccountsCreated is used to get logs from the "AccountCreated" event core contract within given block range
+	RetrieveCoreAccountsCreated(fromBlock uint64, toBLock *uint64) ([]*models.CoreAccountCreated, error)
+
+	// RetrieveCoreAccountsCreatedLimit is used to get all created core accounts and their additional data from the core
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveCoreAccountsCreatedLimit(limit uint64) ([]*models.CoreAccountCreated, error)
+
+	// RetrievePoolsCreated is used to get logs from the "PoolCreated" event core contract within given block range
+	RetrievePoolsCreated(fromBlock uint64, toBLock *uint64) ([]*models.PoolCreated, error)
+
+	// RetrievePoolsCreatedLimit is used to get all created pools and their additional data from the core contract with given
+	// block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrievePoolsCreatedLimit(limit uint64) ([]*models.PoolCreated, error)
+
+	// RetrievePoolConfigurationsSet is used to get logs from the "PoolConfigurationSet" event core contract within given
+	// block range
+	RetrievePoolConfigurationsSet(fromBlock uint64, toBLock *uint64) ([]*models.PoolConfigurationSet, error)
+
+	// RetrievePoolConfigurationsSetLimit is used to get all pool configurations and their additional data from the core
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrievePoolConfigurationsSetLimit(limit uint64) ([]*models.PoolConfigurationSet, error)
+
+	// RetrieveMarketsRegistered is used to get logs from the "MarketRegistered" event core contract within given block range
+	RetrieveMarketsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.MarketRegistered, error)
+
+	// RetrieveMarketsRegisteredLimit is used to get all registered markets and their additional data from the core contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveMarketsRegisteredLimit(limit uint64) ([]*models.MarketRegistered, error)
+
+	// RetrieveMarketCollateralChanges is used to get logs from the "MarketCollateralDeposited" and
+	// "MarketCollateralWithdrawn" events core contract within given block range merged to one stream sorted by block
+	RetrieveMarketCollateralChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketCollateralChange, error)
+
+	// RetrieveMarketCollateralChangesLimit is used to get all market collateral deposits and withdrawals sorted by block
+	// from the core contract with given block search limit. For most public RPC providers the value for limit is 20 000
+	// blocks
+	RetrieveMarketCollateralChangesLimit(limit uint64) ([]*models.MarketCollateralChange, error)
+
+	// RetrieveMarketUSDChanges is used to get logs from the "MarketUsdDeposited" and
+	// "MarketUsdWithdrawn" events core contract within given block range merged to one stream sorted by block
+	RetrieveMarketUSDChanges(fromBlock uint64, toBLock *uint64) ([]*models.MarketUSDChange, error)
+
+	// RetrieveMarketUSDChangesLimit is used to get all market USD deposits and withdrawals sorted by block
+	// from the core contract with given block search limit. For most public RPC providers the value for limit is 20 000
+	// blocks
+	RetrieveMarketUSDChangesLimit(limit uint64) ([]*models.MarketUSDChange, error)
+
+	// RetrieveCoreLiquidations is used to get logs from the "Liquidation" event core contract within given block range
+	RetrieveCoreLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.CoreLiquidation, error)
+
+	// RetrieveCoreLiquidationsLimit is used to get all core LP position liquidations and their additional data from the core
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveCoreLiquidationsLimit(limit uint64) ([]*models.CoreLiquidation, error)
+
+	// RetrieveVaultLiquidations is used to get logs from the "VaultLiquidation" event core contract within given block range
+	RetrieveVaultLiquidations(fromBlock uint64, toBLock *uint64) ([]*models.VaultLiquidation, error)
+
+	// RetrieveVaultLiquidationsLimit is used to get all vault liquidations and their additional data from the core contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveVaultLiquidationsLimit(limit uint64) ([]*models.VaultLiquidation, error)
+
+	// RetrievePermissionChanges is used to get logs from the "PermissionGranted" and "PermissionRevoked" events perps
+	// market contract within given block range merged to one stream sorted by block
+	RetrievePermissionChanges(fromBlock uint64, toBLock *uint64) ([]*models.PermissionChanged, error)
+
+	// RetrievePermissionChangesLimit is used to get all granted and revoked account permissions sorted by block from the
+	// perps market contract with given block search limit. For most public RPC providers the value for limit is 20 000
+	// blocks
+	RetrievePermissionChangesLimit(limit uint64) ([]*models.PermissionChanged, error)
+
+	// RetrieveCollateralConfigured is used to get logs from the "CollateralConfigured" event core contract within given
+	// block range
+	RetrieveCollateralConfigured(fromBlock uint64, toBLock *uint64) ([]*models.CollateralConfiguration, error)
+
+	// RetrieveCollateralConfiguredLimit is used to get all collateral configurations and their additional data from the core
+	// contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveCollateralConfiguredLimit(limit uint64) ([]*models.CollateralConfiguration, error)
+
+	// RetrieveCollateralLockChanges is used to get logs from the "CollateralLockCreated" and "CollateralLockExpired"
+	// events core contract within given block range merged to one stream sorted by block and log index
+	RetrieveCollateralLockChanges(fromBlock uint64, toBLock *uint64) ([]*models.CollateralLock, error)
+
+	// RetrieveCollateralLockChangesLimit is used to get all created and expired collateral locks sorted by block from the
+	// core contract with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrieveCollateralLockChangesLimit(limit uint64) ([]*models.CollateralLock, error)
+
+	// RetrievePoolOwnershipChanges is used to get logs from the "PoolOwnerNominated", "PoolOwnershipAccepted",
+	// "PoolNominationRevoked" and "PoolNominationRenounced" events core contract within given block range merged to one
+	// stream sorted by block and log index
+	RetrievePoolOwnershipChanges(fromBlock uint64, toBLock *uint64) ([]*models.PoolOwnershipChange, error)
+
+	// RetrievePoolOwnershipChangesLimit is used to get all pool ownership nominations, acceptances, revocations and
+	// renouncements sorted by block from the core contract with given block search limit. For most public RPC providers
+	// the value for limit is 20 000 blocks
+	RetrievePoolOwnershipChangesLimit(limit uint64) ([]*models.PoolOwnershipChange, error)
+
+	// RetrievePoolNameUpdates is used to get logs from the "PoolNameUpdated" event core contract within given block range
+	RetrievePoolNameUpdates(fromBlock uint64, toBLock *uint64) ([]*models.PoolNameUpdated, error)
+
+	// RetrievePoolNameUpdatesLimit is used to get all pool name updates and their additional data from the core contract
+	// with given block search limit. For most public RPC providers the value for limit is 20 000 blocks
+	RetrievePoolNameUpdatesLimit(limit uint64) ([]*models.PoolNameUpdated, error)
+
 	// GetPosition is used to get "Position" data struct from the latest block from the perps market with given data
 	GetPosition(accountID *big.Int, marketID *big.Int) (*models.Position, error)
 
+	// GetAccountOpenPositions is used to get all non-zero open positions of given account ID from the latest block with
+	// position market ID included. Positions are fetched concurrently and are not guaranteed to come from the same block
+	GetAccountOpenPositions(accountID *big.Int) ([]*models.Position, error)
+
 	// GetMarketMetadata is used to get market metadata by given market ID
 	GetMarketMetadata(marketID *big.Int) (*models.MarketMetadata, error)
 
@@ -110,38 +509,257 @@ type IService interface {
 	// GetMarketIDs is used to get market IDs from the smart contract
 	GetMarketIDs() ([]*big.Int, error)
 
+	// GetMarkets is used to get metadata of all perps markets. Markets with failed metadata request are skipped
+	GetMarkets() ([]*models.MarketMetadata, error)
+
 	// GetFoundingRate is used to get current founding rate by given market ID
+	//
+	// Deprecated: use GetFundingRate instead
 	GetFoundingRate(marketId *big.Int) (*big.Int, error)
 
-	// GetAvailableMargin is used to get available margin for given account ID
+	// GetFundingRate is used to get current market funding rate by given market ID from the latest block. Returned value
+	// is a signed 18 decimals number. Returns InvalidArgumentErr if market does not exist. Like GetIndexPrice, the rate
+	// is read with the forwarder multicall on Base networks with trusted forwarder
+	GetFundingRate(marketID *big.Int) (*big.Int, error)
+
+	// GetFundingVelocity is used to get current market funding velocity by given market ID from the latest block.
+	// Returns InvalidArgumentErr if market does not exist
+	GetFundingVelocity(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketSize is used to get current market size (open interest) by given market ID from the latest block.
+	// Returned value is an 18 decimals number. The size is taken from the market summary with the same call path as
+	// GetMarketSummary. Returns InvalidArgumentErr if market does not exist
+	GetMarketSize(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketSkew is used to get current market skew by given market ID from the latest block. Returned value is a
+	// signed 18 decimals number, negative for short skewed markets. Returns InvalidArgumentErr if market does not exist
+	GetMarketSkew(marketID *big.Int) (*big.Int, error)
+
+	// GetMaxOpenInterest is used to get max open interest by given market ID from the latest block. Returned value is an
+	// 18 decimals number. Returns InvalidArgumentErr if market does not exist
+	GetMaxOpenInterest(marketID *big.Int) (*big.Int, error)
+
+	// GetMaxMarketSize is used to get max market size (open interest cap in market units) by given market ID from the
+	// latest block. Returns InvalidArgumentErr if market does not exist
+	GetMaxMarketSize(marketID *big.Int) (*big.Int, error)
+
+	// GetIndexPrice is used to get perps market oracle index price by given market ID from the latest block. On Base
+	// networks with trusted forwarder the price is read with the forwarder multicall, which fulfills the Pyth oracle
+	// query on Base mainnet. Other networks return OracleDataRequiredErr if the call reverted with ERC7412
+	// `OracleDataRequired` error and InvalidArgumentErr if market does not exist
+	GetIndexPrice(marketID *big.Int) (*big.Int, error)
+
+	// GetFillPrice is used to get expected fill price for the order with given signed size delta (positive for long and
+	// negative for short orders) in given market using current index price. Index price and fill price are read at the
+	// same latest block, except multicall based networks which always call the latest block. The fill price is the
+	// average of the index price adjusted by the premium/discount before and after the order, so:
+	//   - zero sizeDelta returns the index price adjusted by the current market skew premium/discount
+	//   - sizeDelta large enough to cross the skew flips the premium sign for the part of the order past the zero skew,
+	//     so the fill price moves from the premium to the discount side of the index price (or backwards)
+	GetFillPrice(marketID *big.Int, sizeDelta *big.Int) (*big.Int, error)
+
+	// GetAvailableMargin is used to get available margin for given account ID. Returned value is a signed 18 decimals
+	// number and is negative for underwater accounts
 	GetAvailableMargin(accountId *big.Int) (*big.Int, error)
 
-	// GetLiquidationParameters is used to get liquidation params for given market ID
+	// GetWithdrawableMargin is used to get margin available for withdrawal for given account ID with open positions
+	// requirements taken into account. Returns AccountNotFoundErr if perps account does not exist
+	GetWithdrawableMargin(accountId *big.Int) (*big.Int, error)
+
+	// GetRequiredMarginForOrder is used to get 18 decimals margin required by the contract to commit an order with given
+	// signed size delta in given market for given account ID. Comparing it with GetAvailableMargin allows to reject
+	// orders which would revert with InsufficientMargin. Returns AccountNotFoundErr if perps account does not exist
+	GetRequiredMarginForOrder(accountId *big.Int, marketId *big.Int, sizeDelta *big.Int) (*big.Int, error)
+
+	// GetLiquidationParameters is used to get liquidation params for given market ID from the latest block. Returned
+	// struct is the same one used by RetrieveLiquidationParametersSet so both sources can be mixed
 	GetLiquidationParameters(marketId *big.Int) (*models.LiquidationParameters, error)
 
-	// GetFundingParameters is used to get funding params for given market ID
+	// GetFundingParameters is used to get funding params for given market ID from the latest block. Returns
+	// InvalidArgumentErr if market does not exist
 	GetFundingParameters(marketId *big.Int) (*models.FundingParameters, error)
 
-	// GetAccountLastInteraction is used to get accounts last interaction for given account ID
-	GetAccountLastInteraction(accountId *big.Int) (*big.Int, error)
+	// GetOrderFees is used to get maker and taker fee ratios for given market ID from the latest block. Returned struct is
+	// the same one used by RetrieveOrderFeesSet so both sources can be mixed
+	GetOrderFees(marketId *big.Int) (*models.OrderFees, error)
+
+	// ComputeOrderFees is used to get fees and fill price the contract will use for the order with given signed size delta
+	// in given market at the latest block. Orders that flip the skew are charged with maker fee for the part reducing the
+	// skew and with taker fee for the rest
+	ComputeOrderFees(marketId *big.Int, sizeDelta *big.Int) (*models.OrderFeeQuote, error)
+
+	// GetKeeperRewardGuards is used to get current keeper reward guards from the latest block. Returned struct is the same
+	// one used by RetrieveKeeperRewardGuardsSet so both sources can be mixed
+	GetKeeperRewardGuards() (*models.KeeperRewardGuards, error)
 
-	// GetAccountOwner is used to get accounts owner address for given account ID
+	// GetAccountLastInteraction is used to get accounts last interaction unix timestamp for given account ID. Returns 0
+	// for accounts without any interaction
+	GetAccountLastInteraction(accountId *big.Int) (uint64, error)
+
+	// GetAccountOwner is used to get accounts owner checksummed address for given account ID. Returns
+	// AccountNotFoundErr if perps account does not exist or was burned
 	GetAccountOwner(accountId *big.Int) (string, error)
 
-	// GetCollateralAmount is used to get accounts collateral amount for given market ID
+	// GetAccountPermissions is used to get all users holding permissions on given account ID with their decoded
+	// permission strings (e.g. "ADMIN", "PERPS_COMMIT_ASYNC_ORDER"). Permissions unknown to the models.Permission enum are
+	// kept as decoded raw strings
+	GetAccountPermissions(accountId *big.Int) ([]*models.AccountPermission, error)
+
+	// HasPermission is used to check if given user address was explicitly granted given permission (e.g. "ADMIN",
+	// "PERPS_COMMIT_ASYNC_ORDER") on given account ID. Account owner is not reported unless granted the permission
+	HasPermission(accountId *big.Int, user string, permission string) (bool, error)
+
+	// GetCollateralAmount is used to get accounts collateral amount for given synth market ID. Use 0 as market ID for the
+	// sUSD collateral
 	GetCollateralAmount(accountId *big.Int, marketId *big.Int) (*big.Int, error)
 
+	// GetAccountCollateralIds is used to get synth market IDs of all collaterals deposited by given account ID
+	GetAccountCollateralIds(accountId *big.Int) ([]*big.Int, error)
+
+	// GetTotalCollateralValue is used to get total value of all collaterals deposited by given account ID. Returns
+	// OracleDataRequiredErr if the call reverted with ERC7412 `OracleDataRequired` error
+	GetTotalCollateralValue(accountId *big.Int) (*big.Int, error)
+
+	// GetAccountCollaterals is used to get synth market ID and deposited amount of each collateral of given account ID
+	GetAccountCollaterals(accountId *big.Int) ([]*models.AccountCollateral, error)
+
+	// GetSupportedCollaterals is used to get configuration of each synth supported as perps margin from the latest block.
+	// Collaterals with zero max amount are returned with Disabled flag set
+	GetSupportedCollaterals() ([]*models.PerpsCollateralConfig, error)
+
+	// GetPendingOrder is used to get pending async order of given account ID. Nil is returned without error if account
+	// has no pending order
+	GetPendingOrder(accountId *big.Int) (*models.PendingOrder, error)
+
+	// GetSettlementStrategy is used to get settlement strategy parameters by given market ID and strategy ID. Use
+	// SettlementStrategy.FeedIDHex to get the price feed ID as a hex string
+	GetSettlementStrategy(marketId *big.Int, strategyId *big.Int) (*models.SettlementStrategy, error)
+
 	// GetRequiredMaintenanceMargin is used to get required maintenance margin for given account ID
 	GetRequiredMaintenanceMargin(accountId *big.Int) (*big.Int, error)
 
+	// GetRequiredMargins is used to get required initial margin, required maintenance margin and max liquidation reward
+	// for given account ID from single contract call
+	GetRequiredMargins(accountId *big.Int) (*models.RequiredMargins, error)
+
+	// CanLiquidate is used to check if given account ID can be liquidated at the latest block. On Base networks with
+	// trusted forwarder the check is made with the forwarder multicall, which fulfills Pyth oracle queries for all perps
+	// markets on Base mainnet
+	CanLiquidate(accountId *big.Int) (bool, error)
+
+	// CanLiquidateAccounts is used to check if given account IDs can be liquidated at the latest block using concurrent
+	// contract calls. Results keep given IDs order. Failed checks do not stop the batch: each result holds its own
+	// account error, and BatchErr wrapping every per-account error is returned together with all results
+	CanLiquidateAccounts(accountIds []*big.Int) ([]*models.LiquidationCheck, error)
+
 	// GetCollateralPrice is used to get collateral price for given block number and collateralType
 	GetCollateralPrice(blockNumber *big.Int, collateralType common.Address) (*models.CollateralPrice, error)
 
-	// GetVaultDebt is used to get vault debt for given pool ID and collateralType
+	// GetVaultDebt is used to get vault debt for given pool ID and collateralType. Returned value is a signed 18 decimals
+	// number and is negative when the vault is in credit
 	GetVaultDebt(poolID *big.Int, collateralType common.Address) (*big.Int, error)
 
-	// GetVaultCollateral is used to get vault collateral for given pool ID and collateralType
-	GetVaultCollateral(poolID *big.Int, collateralType common.Address) (amount *big.Int, value *big.Int, err error)
+	// GetMarketReportedDebt is used to get debt reported by the market with given ID to the core. Returns OracleDataRequiredErr
+	// if market price feeds are stale
+	GetMarketReportedDebt(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketTotalDebt is used to get total debt of the market with given ID. Result is a signed 18 decimals number and is
+	// negative when the market is in credit
+	GetMarketTotalDebt(marketID *big.Int) (*big.Int, error)
+
+	// GetWithdrawableMarketUsd is used to get amount of snxUSD the market with given ID can currently withdraw from the core
+	GetWithdrawableMarketUsd(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketDebtSummary is used to get reported debt, total debt and withdrawable snxUSD of the market with given ID
+	GetMarketDebtSummary(marketID *big.Int) (*models.MarketDebtSummary, error)
+
+	// GetAccountAvailableCollateral is used to get amount of given collateralType deposited to the core by the account
+	// which is neither delegated nor locked and can be withdrawn. Never deposited collateral types return 0
+	GetAccountAvailableCollateral(accountID *big.Int, collateralType common.Address) (*big.Int, error)
+
+	// GetMarketNetIssuance is used to get net snxUSD issuance of the market with given ID. Result is a signed 18 decimals
+	// number and is negative when the market deposited more snxUSD than it withdrew
+	GetMarketNetIssuance(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketCollateralValue is used to get USD value of collateral deposited to the core by the market with given ID
+	GetMarketCollateralValue(marketID *big.Int) (*big.Int, error)
+
+	// GetMarketBackingSummary is used to get net issuance, deposited collateral value and reported debt of the market with
+	// given ID. Returns OracleDataRequiredErr if market price feeds are stale
+	GetMarketBackingSummary(marketID *big.Int) (*models.MarketBackingSummary, error)
+
+	// GetMinLiquidityRatio is used to get system wide min liquidity ratio of the core as an 18 decimals number
+	GetMinLiquidityRatio() (*big.Int, error)
+
+	// GetMarketMinLiquidityRatio is used to get min liquidity ratio applied to the market with given ID. System wide
+	// value is returned with SystemDefault flag set if the market has no override
+	GetMarketMinLiquidityRatio(marketID *big.Int) (*models.MinLiquidityRatio, error)
+
+	// GetMarketMinDelegateTime is used to get min time in seconds delegation to the market with given ID must be kept
+	// before it can be decreased
+	GetMarketMinDelegateTime(marketID *big.Int) (uint32, error)
+
+	// GetCollateralConfiguration is used to get current core configuration of given collateralType. Returns NotFoundErr
+	// if the collateral type is not configured
+	GetCollateralConfiguration(collateralType common.Address) (*models.CollateralConfiguration, error)
+
+	// GetCollateralConfigurations is used to get current core configurations of all collateral types. Collaterals with
+	// depositing disabled are skipped by the contract if hideDisabled is true
+	GetCollateralConfigurations(hideDisabled bool) ([]*models.CollateralConfiguration, error)
+
+	// GetPreferredPool is used to get ID of the pool set as preferred by the core owner. Returns 0 if preferred pool is not
+	// set
+	GetPreferredPool() (*big.Int, error)
+
+	// GetApprovedPools is used to get IDs of all pools approved by the core owner. Returns empty slice if no pool is approved
+	GetApprovedPools() ([]*big.Int, error)
+
+	// GetUsdTokenAddress is used to get checksummed address of the snxUSD token used by the core. Result is cached after
+	// the first successful call
+	GetUsdTokenAddress() (string, error)
+
+	// GetOracleManagerAddress is used to get checksummed address of the oracle manager used by the core. Result is cached
+	// after the first successful call
+	GetOracleManagerAddress() (string, error)
+
+	// GetCoreAccountTokenAddress is used to get checksummed address of the core account NFT contract. Result is cached
+	// after the first successful call
+	GetCoreAccountTokenAddress() (string, error)
+
+	// GetPerpsAccountTokenAddress is used to get checksummed address of the perps market account NFT contract. Result is
+	// cached after the first successful call
+	GetPerpsAccountTokenAddress() (string, error)
+
+	// GetPoolName is used to get name of the pool with given ID. Returns PoolNotFoundErr if the pool does not exist
+	GetPoolName(poolID *big.Int) (string, error)
+
+	// GetPoolOwner is used to get checksummed address of the owner of the pool with given ID. Returns PoolNotFoundErr if
+	// the pool does not exist
+	GetPoolOwner(poolID *big.Int) (string, error)
+
+	// GetNominatedPoolOwner is used to get checksummed address nominated as the new owner of the pool with given ID.
+	// Returns blank string if nobody is nominated and PoolNotFoundErr if the pool does not exist
+	GetNominatedPoolOwner(poolID *big.Int) (string, error)
+
+	// GetPoolConfiguration is used to get current markets configuration of given pool ID. Returned entries are the same
+	// ones used by RetrievePoolConfigurationsSet so both sources can be mixed. Not configured pool returns empty slice
+	GetPoolConfiguration(poolID *big.Int) ([]*models.PoolMarketConfiguration, error)
+
+	// GetVaultCollateral is used to get vault collateral amount and its USD value for given pool ID and collateralType
+	GetVaultCollateral(poolID *big.Int, collateralType common.Address) (*models.VaultCollateral, error)
+
+	// GetVaultCollateralRatio is used to get vault collateralization ratio for given pool ID and collateralType as an 18
+	// decimals number (1.5e18 for 150%). Vaults without debt or in credit have infinite ratio which the core returns as
+	// 0, so 0 must be treated as a sentinel rather than an undercollateralized vault
+	GetVaultCollateralRatio(poolID *big.Int, collateralType common.Address) (*big.Int, error)
+
+	// GetPositionCollateral is used to get collateral amount and its USD value delegated by given core account ID to
+	// given pool ID with given collateralType
+	GetPositionCollateral(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*models.PositionCollateral, error)
+
+	// GetPositionDebt is used to get debt of the position of given core account ID in given pool ID with given
+	// collateralType. Returned value is a signed 18 decimals number and is negative when the position is in credit
+	GetPositionDebt(accountID *big.Int, poolID *big.Int, collateralType common.Address) (*big.Int, error)
 
 	// FormatAccount is used to get account, and it's additional data from the contract by given account id
 	FormatAccount(id *big.Int) (*models.Account, error)
@@ -163,22 +781,36 @@ type Service struct {
 
 	core           *core.Core
 	coreFirstBlock uint64
+	// coreCaller is used to execute raw core call data of the getters which are not marked as view
+	coreCaller bind.ContractCaller
 
 	perpsMarket           *perpsMarket.PerpsMarket
 	rawPerpsContract      rawContracts.IRawPerpsContract
 	perpsMarketFirstBlock uint64
 
+	spotMarket           *spotMarket.SpotMarket
+	spotMarketFirstBlock uint64
+
 	rawERC7412   rawContracts.IRawERC7412Contract
 	rawForwarder rawContracts.IRawForwarderContract
 	rawCore      rawContracts.IRawCoreContract
+
+	// system contracts addresses are cached after first retrieval as they are not expected to change
+	addressesMu              sync.Mutex
+	usdTokenAddress          string
+	oracleManagerAddress     string
+	coreAccountTokenAddress  string
+	perpsAccountTokenAddress string
 }
 
-// NewService is used to get instance of Service
+// NewService is used to get instance of Service. Spot market contract is optional, methods which require it will return
+// an error if nil spot market is given
 func NewService(
 	rpc *ethclient.Client,
 	conf *config.PerpsvConfig,
 	core *core.Core,
 	perps *perpsMarket.PerpsMarket,
+	spot *spotMarket.SpotMarket,
 ) (IService, error) {
 	s := &Service{
 		chainID:          conf.ChainID,
@@ -188,9 +820,13 @@ func NewService(
 
 		core:           core,
 		coreFirstBlock: conf.FirstContractBlocks.Core,
+		coreCaller:     rpc,
 
 		perpsMarket:           perps,
 		perpsMarketFirstBlock: conf.FirstContractBlocks.PerpsMarket,
+
+		spotMarket:           spot,
+		spotMarketFirstBlock: conf.FirstContractBlocks.SpotMarket,
 	}
 
 	rawPerpsContract, err := rawContracts.NewPerps(common.HexToAddress(conf.ContractAddresses.PerpsMarket), rpc)
@@ -226,9 +862,18 @@ func NewService(
 	return s, nil
 }
 
-// getIterationsForLimitQuery is used to get iterations of querying data from the contract with given rpc limit for blocks
-// and latest block number. Limit by default (if given limit is 0) is set to 20 000 blocks
+// getIterationsForLimitQuery is used to get iterations of querying data from the perps market contract with given rpc
+// limit for blocks and latest block number. Limit by default (if given limit is 0) is set to 20 000 blocks
 func (s *Service) getIterationsForLimitQuery(limit uint64) (iterations uint64, lastBlock uint64, err error) {
+	return s.getIterationsForLimitQueryFromBlock(limit, s.perpsMarketFirstBlock)
+}
+
+// getIterationsForLimitQueryFromBlock is used to get iterations of querying data from the contract with given rpc limit
+// for blocks starting from given first block. Limit by default (if given limit is 0) is set to 20 000 blocks
+func (s *Service) getIterationsForLimitQueryFromBlock(
+	limit uint64,
+	firstBlock uint64,
+) (iterations uint64, lastBlock uint64, err error) {
 	lastBlock, err = s.rpcClient.BlockNumber(context.Background())
 	if err != nil {
 		logger.Log().WithField("layer", "Service-getIterationsForLimitQuery").Errorf("get latest block rpc error: %v", err.Error())
@@ -239,9 +884,31 @@ func (s *Service) getIterationsForLimitQuery(limit uint64) (iterations uint64, l
 		limit = 20000
 	}
 
-	iterations = (lastBlock-s.perpsMarketFirstBlock)/limit + 1
+	return getLimitQueryIterations(firstBlock, lastBlock, limit), lastBlock, nil
+}
+
+// getLimitQueryIterations is used to get number of iterations needed to query blocks from firstBlock to lastBlock with
+// given limit. Limit queries use inclusive block ranges and start each next range right after the previous one, so
+// every iteration covers limit+1 blocks
+func getLimitQueryIterations(firstBlock uint64, lastBlock uint64, limit uint64) uint64 {
+	if lastBlock < firstBlock {
+		return 0
+	}
 
-	return iterations, lastBlock, nil
+	return (lastBlock-firstBlock)/(limit+1) + 1
+}
+
+// getBlockTime is used to get timestamp of the block with given number
+func (s *Service) getBlockTime(blockN uint64) (uint64, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-getBlockTime").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return 0, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return block.Time, nil
 }
 
 // getFilterOptsPerpsMarket is used to get options for event filtering on perps market contract
@@ -269,3 +936,84 @@ func (s *Service) getFilterOptsCore(fromBlock uint64, toBLock *uint64) *bind.Fil
 		Context: context.Background(),
 	}
 }
+
+// getFilterOptsSpotMarket is used to get options for event filtering on spot market contract
+func (s *Service) getFilterOptsSpotMarket(fromBlock uint64, toBLock *uint64) *bind.FilterOpts {
+	if fromBlock == 0 {
+		fromBlock = s.spotMarketFirstBlock
+	}
+
+	return &bind.FilterOpts{
+		Start:   fromBlock,
+		End:     toBLock,
+		Context: context.Background(),
+	}
+}
+
+var (
+	// oracleDataRequiredSelector is a hex encoded selector of the ERC7412 `OracleDataRequired(address,bytes)` error
+	oracleDataRequiredSelector = getErrorSelector("OracleDataRequired(address,bytes)")
+	// accountNotFoundSelector is a hex encoded selector of the `AccountNotFound(uint128)` error
+	accountNotFoundSelector = getErrorSelector("AccountNotFound(uint128)")
+	// invalidMarketSelector is a hex encoded selector of the spot and perps markets `InvalidMarket(uint128)` error
+	invalidMarketSelector = getErrorSelector("InvalidMarket(uint128)")
+)
+
+// getErrorSelector is used to get hex encoded selector of given custom contract error signature
+func getErrorSelector(signature string) string {
+	return hexutil.Encode(crypto.Keccak256([]byte(signature))[:4])
+}
+
+// isRevertErr is used to check if given contract call error is a revert with custom error of given selector
+func isRevertErr(err error, selector string) bool {
+	dataErr, ok := err.(rpc.DataError)
+	if !ok {
+		return false
+	}
+
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return false
+	}
+
+	return strings.HasPrefix(data, selector)
+}
+
+// isExecutionReverted is used to check if given contract call error is a revert of the call, with or without revert data
+func isExecutionReverted(err error) bool {
+	if _, ok := err.(rpc.DataError); ok {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// batchWorkers is a max number of concurrent contract calls used by the batch view functions
+const batchWorkers = 10
+
+// runBatch is used to call given function for every index in [0, n) concurrently using at most batchWorkers
+// goroutines. Returned errors slice preserves the indexes order
+func runBatch(n int, call func(i int) error) []error {
+	errs := make([]error, n)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < batchWorkers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = call(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLimitQueryIterations(t *testing.T) {
+	testCases := []struct {
+		name       string
+		firstBlock uint64
+		lastBlock  uint64
+		limit      uint64
+	}{
+		{
+			name:       "single block",
+			firstBlock: 100,
+			lastBlock:  100,
+			limit:      20000,
+		},
+		{
+			name:       "less than limit",
+			firstBlock: 100,
+			lastBlock:  15000,
+			limit:      20000,
+		},
+		{
+			name:       "exactly one range",
+			firstBlock: 100,
+			lastBlock:  20100,
+			limit:      20000,
+		},
+		{
+			name:       "one block over one range",
+			firstBlock: 100,
+			lastBlock:  20101,
+			limit:      20000,
+		},
+		{
+			name:       "many ranges",
+			firstBlock: 13044276,
+			lastBlock:  18044276,
+			limit:      20000,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			iterations := getLimitQueryIterations(tt.firstBlock, tt.lastBlock, tt.limit)
+
+			// same ranges as used by the Limit retrievers
+			covered := tt.firstBlock - 1
+			fromBlock := tt.firstBlock
+			toBlock := fromBlock + tt.limit
+			for i := uint64(1); i <= iterations; i++ {
+				require.LessOrEqual(t, fromBlock, toBlock)
+				require.LessOrEqual(t, fromBlock, tt.lastBlock)
+				require.Equal(t, covered+1, fromBlock)
+
+				covered = toBlock
+
+				fromBlock = toBlock + 1
+
+				if i == iterations-1 {
+					toBlock = tt.lastBlock
+				} else {
+					toBlock = fromBlock + tt.limit
+				}
+			}
+
+			require.GreaterOrEqual(t, covered, tt.lastBlock)
+		})
+	}
+
+	require.Equal(t, uint64(0), getLimitQueryIterations(200, 100, 20000))
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) RetrieveSettlementStrategiesAdded(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyAdded, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveSettlementStrategiesAdded(opts)
+}
+
+func (s *Service) RetrieveSettlementStrategiesAddedLimit(limit uint64) ([]*models.SettlementStrategyAdded, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var strategies []*models.SettlementStrategyAdded
+
+	logger.Log().WithField("layer", "Service-RetrieveSettlementStrategiesAddedLimit").Infof(
+		"fetching added settlement strategies with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSettlementStrategiesAddedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSettlementStrategiesAdded(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		strategies = append(strategies, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSettlementStrategiesAddedLimit").Infof("task completed successfully")
+
+	return strategies, nil
+}
+
+// retrieveSettlementStrategiesAdded is used to retrieve added settlement strategies with given filter options
+func (s *Service) retrieveSettlementStrategiesAdded(opts *bind.FilterOpts) ([]*models.SettlementStrategyAdded, error) {
+	iterator, err := s.perpsMarket.FilterSettlementStrategyAdded(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSettlementStrategiesAdded").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var strategies []*models.SettlementStrategyAdded
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSettlementStrategiesAdded").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		strategy, err := s.getSettlementStrategiesAdded(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		strategies = append(strategies, strategy)
+	}
+
+	return strategies, nil
+}
+
+// getSettlementStrategiesAdded is used to get models.SettlementStrategyAdded from given event and block number
+func (s *Service) getSettlementStrategiesAdded(event *perpsMarket.PerpsMarketSettlementStrategyAdded, blockN uint64) (*models.SettlementStrategyAdded, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSettlementStrategiesAdded").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSettlementStrategyAddedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSettlementStrategyUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SettlementStrategyUpdate, error) {
+	opts := s.getFilterOptsPerpsMarket(fromBlock, toBLock)
+	return s.retrieveSettlementStrategyUpdates(opts)
+}
+
+func (s *Service) RetrieveSettlementStrategyUpdatesLimit(limit uint64) ([]*models.SettlementStrategyUpdate, error) {
+	iterations, last, err := s.getIterationsForLimitQuery(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []*models.SettlementStrategyUpdate
+
+	logger.Log().WithField("layer", "Service-RetrieveSettlementStrategyUpdatesLimit").Infof(
+		"fetching settlement strategy updates with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.perpsMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSettlementStrategyUpdatesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsPerpsMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSettlementStrategyUpdates(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSettlementStrategyUpdatesLimit").Infof("task completed successfully")
+
+	return updates, nil
+}
+
+// retrieveSettlementStrategyUpdates is used to retrieve settlement strategy updates with given filter options
+func (s *Service) retrieveSettlementStrategyUpdates(opts *bind.FilterOpts) ([]*models.SettlementStrategyUpdate, error) {
+	iterator, err := s.perpsMarket.FilterSettlementStrategySet(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSettlementStrategyUpdates").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "perps market")
+	}
+
+	var updates []*models.SettlementStrategyUpdate
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSettlementStrategyUpdates").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "perps market")
+		}
+
+		update, err := s.getSettlementStrategyUpdate(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, update)
+	}
+
+	// updates are replayed on top of added strategies, so keep them ordered by block number even if provider returns
+	// logs unordered
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].BlockNumber < updates[j].BlockNumber
+	})
+
+	return updates, nil
+}
+
+// getSettlementStrategyUpdate is used to get models.SettlementStrategyUpdate from given event and block number
+func (s *Service) getSettlementStrategyUpdate(event *perpsMarket.PerpsMarketSettlementStrategySet, blockN uint64) (*models.SettlementStrategyUpdate, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSettlementStrategyUpdates").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSettlementStrategyUpdateFromEvent(event, block.Time), nil
+}
+
+func (s *Service) GetSettlementStrategy(marketId *big.Int, strategyId *big.Int) (*models.SettlementStrategy, error) {
+	if marketId == nil || strategyId == nil {
+		logger.Log().WithField("layer", "Service-GetSettlementStrategy").Errorf("received nil market id or strategy id")
+		return nil, errors.GetInvalidArgumentErr("market id and strategy id cannot be nil")
+	}
+
+	strategy, err := s.perpsMarket.GetSettlementStrategy(nil, marketId, strategyId)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetSettlementStrategy").Errorf("get settlement strategy error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "perps market", "GetSettlementStrategy")
+	}
+
+	res := models.GetSettlementStrategyFromContract(strategy)
+
+	return &res, nil
+}
@@ -0,0 +1,597 @@
+package services
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetSynthAddress(synthMarketID *big.Int) (string, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-GetSynthAddress").Error("no spot market contract")
+		return "", errors.GetBlankContractAddrErr("spot market")
+	}
+
+	if synthMarketID == nil {
+		logger.Log().WithField("layer", "Service-GetSynthAddress").Errorf("received nil synth market id")
+		return "", errors.GetInvalidArgumentErr("synth market id cannot be nil")
+	}
+
+	synth, err := s.spotMarket.GetSynth(nil, synthMarketID)
+	if err != nil {
+		if isRevertErr(err, invalidMarketSelector) {
+			logger.Log().WithField("layer", "Service-GetSynthAddress").Errorf("synth market %v not found", synthMarketID.String())
+			return "", errors.GetNotFoundErr("synth market")
+		}
+
+		logger.Log().WithField("layer", "Service-GetSynthAddress").Errorf("get synth error: %v", err.Error())
+		return "", errors.GetReadContractErr(err, "spot market", "GetSynth")
+	}
+
+	// contract returns zero address for not registered synth markets instead of reverting
+	if synth == (common.Address{}) {
+		logger.Log().WithField("layer", "Service-GetSynthAddress").Errorf("synth market %v not found", synthMarketID.String())
+		return "", errors.GetNotFoundErr("synth market")
+	}
+
+	return synth.Hex(), nil
+}
+
+func (s *Service) RetrieveSynthsBought(fromBlock uint64, toBLock *uint64) ([]*models.SynthBought, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSynthsBought(opts)
+}
+
+func (s *Service) RetrieveSynthsBoughtLimit(limit uint64) ([]*models.SynthBought, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var synths []*models.SynthBought
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsBoughtLimit").Infof(
+		"fetching bought synths with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsBoughtLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSynthsBought(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsBoughtLimit").Infof("task completed successfully")
+
+	return synths, nil
+}
+
+// retrieveSynthsBought is used to retrieve bought synths with given filter options
+func (s *Service) retrieveSynthsBought(opts *bind.FilterOpts) ([]*models.SynthBought, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsBought").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterSynthBought(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsBought").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var synths []*models.SynthBought
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsBought").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		synth, err := s.getSynthBought(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, synth)
+	}
+
+	return synths, nil
+}
+
+// getSynthBought is used to get models.SynthBought from given event and block number
+func (s *Service) getSynthBought(event *spotMarket.SpotMarketSynthBought, blockN uint64) (*models.SynthBought, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsBought").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSynthBoughtFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSynthsSold(fromBlock uint64, toBLock *uint64) ([]*models.SynthSold, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSynthsSold(opts)
+}
+
+func (s *Service) RetrieveSynthsSoldLimit(limit uint64) ([]*models.SynthSold, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var synths []*models.SynthSold
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsSoldLimit").Infof(
+		"fetching sold synths with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsSoldLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSynthsSold(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsSoldLimit").Infof("task completed successfully")
+
+	return synths, nil
+}
+
+// retrieveSynthsSold is used to retrieve sold synths with given filter options
+func (s *Service) retrieveSynthsSold(opts *bind.FilterOpts) ([]*models.SynthSold, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsSold").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterSynthSold(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsSold").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var synths []*models.SynthSold
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsSold").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		synth, err := s.getSynthSold(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, synth)
+	}
+
+	return synths, nil
+}
+
+// getSynthSold is used to get models.SynthSold from given event and block number
+func (s *Service) getSynthSold(event *spotMarket.SpotMarketSynthSold, blockN uint64) (*models.SynthSold, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsSold").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSynthSoldFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSynthsWrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthWrapped, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSynthsWrapped(opts)
+}
+
+func (s *Service) RetrieveSynthsWrappedLimit(limit uint64) ([]*models.SynthWrapped, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	synths := []*models.SynthWrapped{}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsWrappedLimit").Infof(
+		"fetching wrapped synths with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsWrappedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSynthsWrapped(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsWrappedLimit").Infof("task completed successfully")
+
+	return synths, nil
+}
+
+// retrieveSynthsWrapped is used to retrieve wrapped synths with given filter options
+func (s *Service) retrieveSynthsWrapped(opts *bind.FilterOpts) ([]*models.SynthWrapped, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsWrapped").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterSynthWrapped(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsWrapped").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	synths := []*models.SynthWrapped{}
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsWrapped").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		synth, err := s.getSynthWrapped(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, synth)
+	}
+
+	return synths, nil
+}
+
+// getSynthWrapped is used to get models.SynthWrapped from given event and block number
+func (s *Service) getSynthWrapped(event *spotMarket.SpotMarketSynthWrapped, blockN uint64) (*models.SynthWrapped, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsWrapped").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSynthWrappedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSynthsUnwrapped(fromBlock uint64, toBLock *uint64) ([]*models.SynthUnwrapped, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSynthsUnwrapped(opts)
+}
+
+func (s *Service) RetrieveSynthsUnwrappedLimit(limit uint64) ([]*models.SynthUnwrapped, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	synths := []*models.SynthUnwrapped{}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrappedLimit").Infof(
+		"fetching unwrapped synths with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrappedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSynthsUnwrapped(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrappedLimit").Infof("task completed successfully")
+
+	return synths, nil
+}
+
+// retrieveSynthsUnwrapped is used to retrieve unwrapped synths with given filter options
+func (s *Service) retrieveSynthsUnwrapped(opts *bind.FilterOpts) ([]*models.SynthUnwrapped, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrapped").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterSynthUnwrapped(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrapped").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	synths := []*models.SynthUnwrapped{}
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrapped").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		synth, err := s.getSynthUnwrapped(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, synth)
+	}
+
+	return synths, nil
+}
+
+// getSynthUnwrapped is used to get models.SynthUnwrapped from given event and block number
+func (s *Service) getSynthUnwrapped(event *spotMarket.SpotMarketSynthUnwrapped, blockN uint64) (*models.SynthUnwrapped, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsUnwrapped").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSynthUnwrappedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSynthsRegistered(fromBlock uint64, toBLock *uint64) ([]*models.SynthRegistered, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSynthsRegistered(opts)
+}
+
+func (s *Service) RetrieveSynthsRegisteredLimit(limit uint64) ([]*models.SynthRegistered, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	synths := []*models.SynthRegistered{}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsRegisteredLimit").Infof(
+		"fetching registered synths with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsRegisteredLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSynthsRegistered(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSynthsRegisteredLimit").Infof("task completed successfully")
+
+	return synths, nil
+}
+
+// retrieveSynthsRegistered is used to retrieve registered synths with given filter options
+func (s *Service) retrieveSynthsRegistered(opts *bind.FilterOpts) ([]*models.SynthRegistered, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsRegistered").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterSynthRegistered(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsRegistered").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	synths := []*models.SynthRegistered{}
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSynthsRegistered").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		synth, err := s.getSynthRegistered(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		synths = append(synths, synth)
+	}
+
+	return synths, nil
+}
+
+// getSynthRegistered is used to get models.SynthRegistered from given event and block number
+func (s *Service) getSynthRegistered(event *spotMarket.SpotMarketSynthRegistered, blockN uint64) (*models.SynthRegistered, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSynthsRegistered").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSynthRegisteredFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveWrappersSet(fromBlock uint64, toBLock *uint64) ([]*models.WrapperSet, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveWrappersSet(opts)
+}
+
+func (s *Service) RetrieveWrappersSetLimit(limit uint64) ([]*models.WrapperSet, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappers := []*models.WrapperSet{}
+
+	logger.Log().WithField("layer", "Service-RetrieveWrappersSetLimit").Infof(
+		"fetching wrapper configurations with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveWrappersSetLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveWrappersSet(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		wrappers = append(wrappers, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveWrappersSetLimit").Infof("task completed successfully")
+
+	return wrappers, nil
+}
+
+// retrieveWrappersSet is used to retrieve wrapper configurations with given filter options
+func (s *Service) retrieveWrappersSet(opts *bind.FilterOpts) ([]*models.WrapperSet, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveWrappersSet").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterWrapperSet(opts, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveWrappersSet").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	wrappers := []*models.WrapperSet{}
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveWrappersSet").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		wrapper, err := s.getWrapperSet(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		wrappers = append(wrappers, wrapper)
+	}
+
+	return wrappers, nil
+}
+
+// getWrapperSet is used to get models.WrapperSet from given event and block number
+func (s *Service) getWrapperSet(event *spotMarket.SpotMarketWrapperSet, blockN uint64) (*models.WrapperSet, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveWrappersSet").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetWrapperSetFromEvent(event, block.Time), nil
+}
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetSpotMarketFees(synthMarketID *big.Int) (*models.SpotMarketFees, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-GetSpotMarketFees").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	if synthMarketID == nil {
+		logger.Log().WithField("layer", "Service-GetSpotMarketFees").Errorf("received nil synth market id")
+		return nil, errors.GetInvalidArgumentErr("synth market id cannot be nil")
+	}
+
+	fees, err := s.spotMarket.GetMarketFees(nil, synthMarketID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetSpotMarketFees").Errorf("get market fees error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "spot market", "GetMarketFees")
+	}
+
+	utilizationFeeRate, err := s.spotMarket.GetMarketUtilizationFees(nil, synthMarketID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetSpotMarketFees").Errorf("get market utilization fees error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "spot market", "GetMarketUtilizationFees")
+	}
+
+	skewScale, err := s.spotMarket.GetMarketSkewScale(nil, synthMarketID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetSpotMarketFees").Errorf("get market skew scale error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "spot market", "GetMarketSkewScale")
+	}
+
+	return models.GetSpotMarketFees(synthMarketID, fees, utilizationFeeRate, skewScale), nil
+}
+
+func (s *Service) RetrieveSpotMarketFeeUpdates(fromBlock uint64, toBLock *uint64) ([]*models.SpotFeeUpdate, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSpotMarketFeeUpdates(opts)
+}
+
+func (s *Service) RetrieveSpotMarketFeeUpdatesLimit(limit uint64) ([]*models.SpotFeeUpdate, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := []*models.SpotFeeUpdate{}
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdatesLimit").Infof(
+		"fetching spot market fee updates with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdatesLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSpotMarketFeeUpdates(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdatesLimit").Infof("task completed successfully")
+
+	return updates, nil
+}
+
+// retrieveSpotMarketFeeUpdates is used to retrieve spot market fee updates with given filter options. Fee
+// configuration is spread across "AtomicFixedFeeSet", "AsyncFixedFeeSet", "MarketUtilizationFeesSet" and
+// "MarketSkewScaleSet" events, so each of them is filtered separately and the results are merged
+func (s *Service) retrieveSpotMarketFeeUpdates(opts *bind.FilterOpts) ([]*models.SpotFeeUpdate, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	updates := []*models.SpotFeeUpdate{}
+
+	atomic, err := s.retrieveAtomicFixedFeeUpdates(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	async, err := s.retrieveAsyncFixedFeeUpdates(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization, err := s.retrieveUtilizationFeeUpdates(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	skew, err := s.retrieveSkewScaleUpdates(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	updates = append(updates, atomic...)
+	updates = append(updates, async...)
+	updates = append(updates, utilization...)
+	updates = append(updates, skew...)
+
+	// updates come from different filterers, so order them by block number and log index to make them replayable
+	sort.SliceStable(updates, func(i, j int) bool {
+		if updates[i].BlockNumber != updates[j].BlockNumber {
+			return updates[i].BlockNumber < updates[j].BlockNumber
+		}
+
+		return updates[i].LogIndex < updates[j].LogIndex
+	})
+
+	return updates, nil
+}
+
+// retrieveAtomicFixedFeeUpdates is used to retrieve "AtomicFixedFeeSet" events as spot market fee updates with given
+// filter options
+func (s *Service) retrieveAtomicFixedFeeUpdates(opts *bind.FilterOpts) ([]*models.SpotFeeUpdate, error) {
+	iterator, err := s.spotMarket.FilterAtomicFixedFeeSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("error get atomic fixed fee iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var updates []*models.SpotFeeUpdate
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("atomic fixed fee iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, models.GetSpotFeeUpdateFromAtomicFixedFeeSetEvent(iterator.Event, blockTime))
+	}
+
+	return updates, nil
+}
+
+// retrieveAsyncFixedFeeUpdates is used to retrieve "AsyncFixedFeeSet" events as spot market fee updates with given
+// filter options
+func (s *Service) retrieveAsyncFixedFeeUpdates(opts *bind.FilterOpts) ([]*models.SpotFeeUpdate, error) {
+	iterator, err := s.spotMarket.FilterAsyncFixedFeeSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("error get async fixed fee iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var updates []*models.SpotFeeUpdate
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("async fixed fee iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, models.GetSpotFeeUpdateFromAsyncFixedFeeSetEvent(iterator.Event, blockTime))
+	}
+
+	return updates, nil
+}
+
+// retrieveUtilizationFeeUpdates is used to retrieve "MarketUtilizationFeesSet" events as spot market fee updates with
+// given filter options
+func (s *Service) retrieveUtilizationFeeUpdates(opts *bind.FilterOpts) ([]*models.SpotFeeUpdate, error) {
+	iterator, err := s.spotMarket.FilterMarketUtilizationFeesSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("error get utilization fees iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var updates []*models.SpotFeeUpdate
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("utilization fees iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, models.GetSpotFeeUpdateFromMarketUtilizationFeesSetEvent(iterator.Event, blockTime))
+	}
+
+	return updates, nil
+}
+
+// retrieveSkewScaleUpdates is used to retrieve "MarketSkewScaleSet" events as spot market fee updates with given
+// filter options
+func (s *Service) retrieveSkewScaleUpdates(opts *bind.FilterOpts) ([]*models.SpotFeeUpdate, error) {
+	iterator, err := s.spotMarket.FilterMarketSkewScaleSet(opts, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("error get skew scale iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var updates []*models.SpotFeeUpdate
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotMarketFeeUpdates").Errorf("skew scale iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		blockTime, err := s.getBlockTime(iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, models.GetSpotFeeUpdateFromMarketSkewScaleSetEvent(iterator.Event, blockTime))
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+// defaultStalenessTolerance is the spot market `DEFAULT` price staleness tolerance used for quotes
+const defaultStalenessTolerance = uint8(0)
+
+func (s *Service) GetQuoteBuyExactIn(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error) {
+	return s.getSpotQuote(synthMarketID, usdAmount, "QuoteBuyExactIn",
+		func(opts *bind.CallOpts, marketId *big.Int, amount *big.Int) (*big.Int, spotMarket.OrderFeesData, error) {
+			res, err := s.spotMarket.QuoteBuyExactIn(opts, marketId, amount, defaultStalenessTolerance)
+			return res.SynthAmount, res.Fees, err
+		},
+	)
+}
+
+func (s *Service) GetQuoteBuyExactOut(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error) {
+	return s.getSpotQuote(synthMarketID, synthAmount, "QuoteBuyExactOut",
+		func(opts *bind.CallOpts, marketId *big.Int, amount *big.Int) (*big.Int, spotMarket.OrderFeesData, error) {
+			res, err := s.spotMarket.QuoteBuyExactOut(opts, marketId, amount, defaultStalenessTolerance)
+			return res.UsdAmountCharged, res.Fees, err
+		},
+	)
+}
+
+func (s *Service) GetQuoteSellExactIn(synthMarketID *big.Int, synthAmount *big.Int) (*models.SpotQuote, error) {
+	return s.getSpotQuote(synthMarketID, synthAmount, "QuoteSellExactIn",
+		func(opts *bind.CallOpts, marketId *big.Int, amount *big.Int) (*big.Int, spotMarket.OrderFeesData, error) {
+			res, err := s.spotMarket.QuoteSellExactIn(opts, marketId, amount, defaultStalenessTolerance)
+			return res.ReturnAmount, res.Fees, err
+		},
+	)
+}
+
+func (s *Service) GetQuoteSellExactOut(synthMarketID *big.Int, usdAmount *big.Int) (*models.SpotQuote, error) {
+	return s.getSpotQuote(synthMarketID, usdAmount, "QuoteSellExactOut",
+		func(opts *bind.CallOpts, marketId *big.Int, amount *big.Int) (*big.Int, spotMarket.OrderFeesData, error) {
+			res, err := s.spotMarket.QuoteSellExactOut(opts, marketId, amount, defaultStalenessTolerance)
+			return res.SynthToBurn, res.Fees, err
+		},
+	)
+}
+
+// getSpotQuote is used to get models.SpotQuote for given synth market ID and amount using given spot market quote
+// contract method call
+func (s *Service) getSpotQuote(
+	synthMarketID *big.Int,
+	amount *big.Int,
+	method string,
+	call func(opts *bind.CallOpts, marketId *big.Int, amount *big.Int) (*big.Int, spotMarket.OrderFeesData, error),
+) (*models.SpotQuote, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-Get"+method).Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	if synthMarketID == nil || amount == nil {
+		logger.Log().WithField("layer", "Service-Get"+method).Errorf("received nil synth market id or amount")
+		return nil, errors.GetInvalidArgumentErr("synth market id and amount cannot be nil")
+	}
+
+	res, fees, err := call(nil, synthMarketID, amount)
+	if err != nil {
+		if isRevertErr(err, invalidMarketSelector) {
+			logger.Log().WithField("layer", "Service-Get"+method).Errorf("synth market %v not found", synthMarketID.String())
+			return nil, errors.GetNotFoundErr("synth market")
+		}
+
+		if isRevertErr(err, oracleDataRequiredSelector) {
+			logger.Log().WithField("layer", "Service-Get"+method).Errorf("oracle data required")
+			return nil, errors.GetOracleDataRequiredErr(err, "spot market", method)
+		}
+
+		logger.Log().WithField("layer", "Service-Get"+method).Errorf("get quote error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "spot market", method)
+	}
+
+	return models.GetSpotQuote(synthMarketID, res, fees), nil
+}
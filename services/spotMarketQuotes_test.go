@@ -0,0 +1,149 @@
+package services
+
+import (
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+)
+
+func TestService_GetQuotes(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	amount, _ := new(big.Int).SetString("1000000000000000000", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		amount  *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			amount:  amount,
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:    "nil amount",
+			id:      big.NewInt(1),
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:   "registered market",
+			id:     big.NewInt(1),
+			amount: amount,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+			for _, quote := range []func(*big.Int, *big.Int) (*models.SpotQuote, error){
+				s.GetQuoteBuyExactIn,
+				s.GetQuoteBuyExactOut,
+				s.GetQuoteSellExactIn,
+				s.GetQuoteSellExactOut,
+			} {
+				res, err := quote(tt.id, tt.amount)
+
+				if tt.wantErr == nil {
+					require.NoError(t, err)
+					require.Equal(t, tt.id.Uint64(), res.SynthMarketID)
+					require.Equal(t, 1, res.Amount.Sign())
+				} else {
+					require.Error(t, err)
+					require.ErrorIs(t, err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestService_GetQuoteBuyExactIn_RecordedCall(t *testing.T) {
+	// quoteBuyExactIn return data fixture for 0.5 synth units with 0.001 fixed fee and -0.0002 skew fee
+	data := common.FromHex("0x" +
+		"00000000000000000000000000000000000000000000000006f05b59d3b20000" +
+		"00000000000000000000000000000000000000000000000000038d7ea4c68000" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffff4a19df0b8000" +
+		"0000000000000000000000000000000000000000000000000000000000000000",
+	)
+
+	spot, err := spotMarket.NewSpotMarketCaller(
+		common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{spotMarket: &spotMarket.SpotMarket{SpotMarketCaller: *spot}}
+
+	res, err := s.GetQuoteBuyExactIn(big.NewInt(1), big.NewInt(1000000000000000000))
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.SynthMarketID)
+	require.Equal(t, "500000000000000000", res.Amount.String())
+	require.Equal(t, "1000000000000000", res.Fees.FixedFees.String())
+	require.Equal(t, "0", res.Fees.UtilizationFees.String())
+	require.Equal(t, "-200000000000000", res.Fees.SkewFees.String())
+	require.Equal(t, "0", res.Fees.WrapperFees.String())
+}
+
+func TestService_GetQuoteSellExactOut_RecordedCall(t *testing.T) {
+	// quoteSellExactOut return data fixture for 2 synth units to burn with 0.003 utilization fee, 0.0005 skew fee and
+	// -0.0001 wrapper fee
+	data := common.FromHex("0x" +
+		"0000000000000000000000000000000000000000000000001bc16d674ec80000" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"000000000000000000000000000000000000000000000000000aa87bee538000" +
+		"0000000000000000000000000000000000000000000000000001c6bf52634000" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffa50cef85c000",
+	)
+
+	spot, err := spotMarket.NewSpotMarketCaller(
+		common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"),
+		&recordedCaller{data: data},
+	)
+	require.NoError(t, err)
+
+	s := &Service{spotMarket: &spotMarket.SpotMarket{SpotMarketCaller: *spot}}
+
+	res, err := s.GetQuoteSellExactOut(big.NewInt(1), big.NewInt(1000000000000000000))
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.SynthMarketID)
+	require.Equal(t, "2000000000000000000", res.Amount.String())
+	require.Equal(t, "0", res.Fees.FixedFees.String())
+	require.Equal(t, "3000000000000000", res.Fees.UtilizationFees.String())
+	require.Equal(t, "500000000000000", res.Fees.SkewFees.String())
+	require.Equal(t, "-100000000000000", res.Fees.WrapperFees.String())
+}
+
+func TestService_getSpotQuote_NoSpotMarket(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.GetQuoteBuyExactIn(big.NewInt(1), big.NewInt(1))
+
+	require.ErrorIs(t, err, errors.BlankContractAddrErr)
+}
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+)
+
+func TestService_RetrieveSynthsBought_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSynthsBoughtLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSynthsBought_NoSpotMarket(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.RetrieveSynthsBought(1, nil)
+
+	require.ErrorIs(t, err, errors.BlankContractAddrErr)
+}
+
+func TestService_RetrieveSynthsSold_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSynthsSoldLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSynthsWrapped_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSynthsWrappedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSynthsUnwrapped_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSynthsUnwrappedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSynthsRegistered_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSynthsRegisteredLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveWrappersSet_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveWrappersSetLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSpotMarketFeeUpdates_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSpotMarketFeeUpdatesLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSpotMarketFeeUpdates_NoSpotMarket(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.RetrieveSpotMarketFeeUpdates(0, nil)
+
+	require.ErrorIs(t, err, errors.BlankContractAddrErr)
+}
+
+func TestService_GetSynthAddress(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	// max uint128 value is never reached by the synth market id counter
+	notExistingID, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:    "not registered market",
+			id:      notExistingID,
+			wantErr: errors.NotFoundErr,
+		},
+		{
+			name: "registered market",
+			id:   big.NewInt(1),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+			res, err := s.GetSynthAddress(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, common.HexToAddress(res).Hex(), res)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetSynthAddress_NoSpotMarket(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.GetSynthAddress(big.NewInt(1))
+
+	require.ErrorIs(t, err, errors.BlankContractAddrErr)
+}
+
+func TestService_GetSpotMarketFees(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	testCases := []struct {
+		name    string
+		id      *big.Int
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name: "registered market",
+			id:   big.NewInt(1),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+			res, err := s.GetSpotMarketFees(tt.id)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tt.id.Uint64(), res.SynthMarketID)
+				require.NotNil(t, res.AtomicFixedFee)
+				require.NotNil(t, res.AsyncFixedFee)
+				require.NotNil(t, res.WrapFee)
+				require.NotNil(t, res.UnwrapFee)
+				require.NotNil(t, res.UtilizationFeeRate)
+				require.NotNil(t, res.SkewScale)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetSpotMarketFees_NoSpotMarket(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.GetSpotMarketFees(big.NewInt(1))
+
+	require.ErrorIs(t, err, errors.BlankContractAddrErr)
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/models"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetSpotSettlementStrategy(synthMarketID *big.Int, strategyID *big.Int) (*models.SpotSettlementStrategy, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-GetSpotSettlementStrategy").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	if synthMarketID == nil || strategyID == nil {
+		logger.Log().WithField("layer", "Service-GetSpotSettlementStrategy").Errorf("received nil synth market id or strategy id")
+		return nil, errors.GetInvalidArgumentErr("synth market id and strategy id cannot be nil")
+	}
+
+	strategy, err := s.spotMarket.GetSettlementStrategy(nil, synthMarketID, strategyID)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-GetSpotSettlementStrategy").Errorf("get settlement strategy error: %v", err.Error())
+		return nil, errors.GetReadContractErr(err, "spot market", "GetSettlementStrategy")
+	}
+
+	return models.GetSpotSettlementStrategyFromContract(strategy), nil
+}
+
+func (s *Service) RetrieveSpotOrdersCommitted(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCommitted, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSpotOrdersCommitted(opts)
+}
+
+func (s *Service) RetrieveSpotOrdersCommittedLimit(limit uint64) ([]*models.SpotOrderCommitted, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*models.SpotOrderCommitted
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommittedLimit").Infof(
+		"fetching committed spot orders with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommittedLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSpotOrdersCommitted(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommittedLimit").Infof("task completed successfully")
+
+	return orders, nil
+}
+
+// retrieveSpotOrdersCommitted is used to retrieve committed spot orders with given filter options
+func (s *Service) retrieveSpotOrdersCommitted(opts *bind.FilterOpts) ([]*models.SpotOrderCommitted, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommitted").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterOrderCommitted(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommitted").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var orders []*models.SpotOrderCommitted
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommitted").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		order, err := s.getSpotOrderCommitted(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// getSpotOrderCommitted is used to get models.SpotOrderCommitted from given event and block number
+func (s *Service) getSpotOrderCommitted(event *spotMarket.SpotMarketOrderCommitted, blockN uint64) (*models.SpotOrderCommitted, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCommitted").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSpotOrderCommittedFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSpotOrdersSettled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderSettled, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSpotOrdersSettled(opts)
+}
+
+func (s *Service) RetrieveSpotOrdersSettledLimit(limit uint64) ([]*models.SpotOrderSettled, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*models.SpotOrderSettled
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettledLimit").Infof(
+		"fetching settled spot orders with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettledLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSpotOrdersSettled(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettledLimit").Infof("task completed successfully")
+
+	return orders, nil
+}
+
+// retrieveSpotOrdersSettled is used to retrieve settled spot orders with given filter options
+func (s *Service) retrieveSpotOrdersSettled(opts *bind.FilterOpts) ([]*models.SpotOrderSettled, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettled").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterOrderSettled(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettled").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var orders []*models.SpotOrderSettled
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettled").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		order, err := s.getSpotOrderSettled(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// getSpotOrderSettled is used to get models.SpotOrderSettled from given event and block number
+func (s *Service) getSpotOrderSettled(event *spotMarket.SpotMarketOrderSettled, blockN uint64) (*models.SpotOrderSettled, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersSettled").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSpotOrderSettledFromEvent(event, block.Time), nil
+}
+
+func (s *Service) RetrieveSpotOrdersCancelled(fromBlock uint64, toBLock *uint64) ([]*models.SpotOrderCancelled, error) {
+	opts := s.getFilterOptsSpotMarket(fromBlock, toBLock)
+	return s.retrieveSpotOrdersCancelled(opts)
+}
+
+func (s *Service) RetrieveSpotOrdersCancelledLimit(limit uint64) ([]*models.SpotOrderCancelled, error) {
+	iterations, last, err := s.getIterationsForLimitQueryFromBlock(limit, s.spotMarketFirstBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*models.SpotOrderCancelled
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelledLimit").Infof(
+		"fetching cancelled spot orders with limit: %v to block: %v total iterations: %v...",
+		limit, last, iterations,
+	)
+
+	fromBlock := s.spotMarketFirstBlock
+	toBlock := fromBlock + limit
+	for i := uint64(1); i <= iterations; i++ {
+		if i%10 == 0 || i == iterations {
+			logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelledLimit").Infof("-- iteration %v", i)
+		}
+		opts := s.getFilterOptsSpotMarket(fromBlock, &toBlock)
+
+		res, err := s.retrieveSpotOrdersCancelled(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, res...)
+
+		fromBlock = toBlock + 1
+
+		if i == iterations-1 {
+			toBlock = last
+		} else {
+			toBlock = fromBlock + limit
+		}
+	}
+
+	logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelledLimit").Infof("task completed successfully")
+
+	return orders, nil
+}
+
+// retrieveSpotOrdersCancelled is used to retrieve cancelled spot orders with given filter options
+func (s *Service) retrieveSpotOrdersCancelled(opts *bind.FilterOpts) ([]*models.SpotOrderCancelled, error) {
+	if s.spotMarket == nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelled").Error("no spot market contract")
+		return nil, errors.GetBlankContractAddrErr("spot market")
+	}
+
+	iterator, err := s.spotMarket.FilterOrderCancelled(opts, nil, nil, nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelled").Errorf("error get iterator: %v", err.Error())
+		return nil, errors.GetFilterErr(err, "spot market")
+	}
+
+	var orders []*models.SpotOrderCancelled
+
+	for iterator.Next() {
+		if iterator.Error() != nil {
+			logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelled").Errorf("iterator error: %v", iterator.Error().Error())
+			return nil, errors.GetFilterErr(iterator.Error(), "spot market")
+		}
+
+		order, err := s.getSpotOrderCancelled(iterator.Event, iterator.Event.Raw.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// getSpotOrderCancelled is used to get models.SpotOrderCancelled from given event and block number
+func (s *Service) getSpotOrderCancelled(event *spotMarket.SpotMarketOrderCancelled, blockN uint64) (*models.SpotOrderCancelled, error) {
+	block, err := s.rpcClient.HeaderByNumber(context.Background(), big.NewInt(int64(blockN)))
+	if err != nil {
+		logger.Log().WithField("layer", "Service-RetrieveSpotOrdersCancelled").Errorf(
+			"get block:%v by number error: %v", blockN, err.Error(),
+		)
+		return nil, errors.GetRPCProviderErr(err, "HeaderByNumber")
+	}
+
+	return models.GetSpotOrderCancelledFromEvent(event, block.Time), nil
+}
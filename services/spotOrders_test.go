@@ -0,0 +1,142 @@
+package services
+
+import (
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/contracts/spotMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+)
+
+func TestService_RetrieveSpotOrdersCommitted_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSpotOrdersCommittedLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSpotOrdersSettled_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSpotOrdersSettledLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_RetrieveSpotOrdersCancelled_OnChain_Limit(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+	_, err := s.RetrieveSpotOrdersCancelledLimit(20000)
+
+	require.NoError(t, err)
+}
+
+func TestService_GetSpotSettlementStrategy(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+	spot, _ := spotMarket.NewSpotMarket(common.HexToAddress("0x26f3EcFa0Aa924649cfd4b74C57637e910A983a4"), rpcClient)
+
+	testCases := []struct {
+		name       string
+		id         *big.Int
+		strategyID *big.Int
+		wantErr    error
+	}{
+		{
+			name:       "nil id",
+			strategyID: big.NewInt(0),
+			wantErr:    errors.InvalidArgumentErr,
+		},
+		{
+			name:    "nil strategy id",
+			id:      big.NewInt(1),
+			wantErr: errors.InvalidArgumentErr,
+		},
+		{
+			name:       "first strategy",
+			id:         big.NewInt(1),
+			strategyID: big.NewInt(0),
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := NewService(rpcClient, conf, coreC, perps, spot)
+
+			res, err := s.GetSpotSettlementStrategy(tt.id, tt.strategyID)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				require.NotNil(t, res.SettlementWindowDuration)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestService_GetSpotSettlementStrategy_NoSpotMarket(t *testing.T) {
+	s := &Service{}
+
+	_, err := s.GetSpotSettlementStrategy(big.NewInt(1), big.NewInt(0))
+
+	require.ErrorIs(t, err, errors.BlankContractAddrErr)
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/gateway-fm/perpsv3-Go/errors"
+	"github.com/gateway-fm/perpsv3-Go/pkg/logger"
+)
+
+func (s *Service) GetUsdTokenAddress() (string, error) {
+	return s.getCachedAddress(&s.usdTokenAddress, "core", "getUsdToken", s.core.GetUsdToken)
+}
+
+func (s *Service) GetOracleManagerAddress() (string, error) {
+	return s.getCachedAddress(&s.oracleManagerAddress, "core", "getOracleManager", s.core.GetOracleManager)
+}
+
+func (s *Service) GetCoreAccountTokenAddress() (string, error) {
+	return s.getCachedAddress(&s.coreAccountTokenAddress, "core", "getAccountTokenAddress", s.core.GetAccountTokenAddress)
+}
+
+func (s *Service) GetPerpsAccountTokenAddress() (string, error) {
+	return s.getCachedAddress(
+		&s.perpsAccountTokenAddress, "perps market", "getAccountTokenAddress", s.perpsMarket.GetAccountTokenAddress,
+	)
+}
+
+// getCachedAddress is used to get checksummed address from the given cache field, calling given contract getter and
+// filling the cache on the first call. Blank addresses are returned as is but never cached
+func (s *Service) getCachedAddress(
+	cache *string,
+	contract string,
+	method string,
+	call func(opts *bind.CallOpts) (common.Address, error),
+) (string, error) {
+	s.addressesMu.Lock()
+	defer s.addressesMu.Unlock()
+
+	if *cache != "" {
+		return *cache, nil
+	}
+
+	addr, err := call(nil)
+	if err != nil {
+		logger.Log().WithField("layer", "Service-getCachedAddress").Errorf(
+			"error from the contract calling %v: %v", method, err.Error(),
+		)
+		return "", errors.GetReadContractErr(err, contract, method)
+	}
+
+	if addr != (common.Address{}) {
+		*cache = addr.Hex()
+	}
+
+	return addr.Hex(), nil
+}
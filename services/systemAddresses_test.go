@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gateway-fm/perpsv3-Go/config"
+	"github.com/gateway-fm/perpsv3-Go/contracts/core"
+	"github.com/gateway-fm/perpsv3-Go/contracts/perpsMarket"
+	"github.com/gateway-fm/perpsv3-Go/errors"
+)
+
+// countingCaller is a test bind.ContractCaller implementation returning given recorded call data or error and counting
+// contract calls
+type countingCaller struct {
+	data  []byte
+	err   error
+	calls int
+}
+
+func (c *countingCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *countingCaller) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.calls++
+	return c.data, c.err
+}
+
+func TestService_GetUsdTokenAddress_Cached(t *testing.T) {
+	usd := common.HexToAddress("0x09d51516F38980035153a554c26Df3C6f51a23C3")
+
+	testCases := []struct {
+		name      string
+		data      []byte
+		err       error
+		want      string
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "address cached",
+			data:      common.LeftPadBytes(usd.Bytes(), 32),
+			want:      usd.Hex(),
+			wantCalls: 1,
+		},
+		{
+			name:      "blank address not cached",
+			data:      make([]byte, 32),
+			want:      common.Address{}.Hex(),
+			wantCalls: 2,
+		},
+		{
+			name:      "rpc error not cached",
+			err:       fmt.Errorf("connection refused"),
+			wantCalls: 2,
+			wantErr:   errors.ReadContractErr,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &countingCaller{data: tt.data, err: tt.err}
+
+			caller, err := core.NewCoreCaller(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), counter)
+			require.NoError(t, err)
+
+			s := &Service{core: &core.Core{CoreCaller: *caller}}
+
+			for i := 0; i < 2; i++ {
+				res, err := s.GetUsdTokenAddress()
+
+				if tt.wantErr == nil {
+					require.NoError(t, err)
+					require.Equal(t, tt.want, res)
+				} else {
+					require.ErrorIs(t, err, tt.wantErr)
+				}
+			}
+
+			require.Equal(t, tt.wantCalls, counter.calls)
+		})
+	}
+}
+
+func TestService_GetSystemAddresses_OnChain(t *testing.T) {
+	rpc := os.Getenv("TEST_RPC")
+	if rpc == "" {
+		log.Fatal("no rpc in env vars")
+	}
+
+	rpcClient, _ := ethclient.Dial(rpc)
+
+	conf := config.GetBaseAndromedaDefaultConfig(rpc)
+
+	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
+	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
+
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
+
+	for name, get := range map[string]func() (string, error){
+		"usd token":           s.GetUsdTokenAddress,
+		"oracle manager":      s.GetOracleManagerAddress,
+		"core account token":  s.GetCoreAccountTokenAddress,
+		"perps account token": s.GetPerpsAccountTokenAddress,
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := get()
+
+			require.NoError(t, err)
+			require.True(t, common.IsHexAddress(res))
+			require.Equal(t, common.HexToAddress(res).Hex(), res)
+			require.NotEqual(t, common.Address{}.Hex(), res)
+		})
+	}
+}
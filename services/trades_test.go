@@ -89,7 +89,7 @@ func TestService_RetrieveTrades_OnChain(t *testing.T) {
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			s, _ := NewService(rpcClient, conf, coreC, perps)
+			s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 			res, err := s.RetrieveTrades(tt.startBlock, &tt.endBlock)
 
 			require.NoError(t, err)
@@ -134,7 +134,7 @@ func TestService_RetrieveTrades_OnChain_Limit(t *testing.T) {
 	coreC, _ := core.NewCore(common.HexToAddress("0x76490713314fCEC173f44e99346F54c6e92a8E42"), rpcClient)
 	perps, _ := perpsMarket.NewPerpsMarket(common.HexToAddress("0xf272382cB3BE898A8CdB1A23BE056fA2Fcf4513b"), rpcClient)
 
-	s, _ := NewService(rpcClient, conf, coreC, perps)
+	s, _ := NewService(rpcClient, conf, coreC, perps, nil)
 
 	res, err := s.RetrieveTradesLimit(20000)
 